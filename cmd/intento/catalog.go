@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"sort"
+	"strconv"
+
+	"intento-golang/intento"
+)
+
+func runProviders(ctx context.Context, env *environment, args []string) error {
+	flags := flag.NewFlagSet("providers", flag.ContinueOnError)
+	flags.SetOutput(env.stderr)
+
+	from := flags.String("from", "", "only providers translating from the language")
+	to := flags.String("to", "", "only providers translating to the language")
+	customModel := flags.Bool("custom-model", false, "only providers supporting custom models")
+	asyncOnly := flags.Bool("async-only", false, "only providers working in the asynchronous mode only")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := env.client.ProviderCatalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	var filters []intento.ProviderFilter
+
+	if *customModel {
+		filters = append(filters, intento.ProviderCustomModel())
+	}
+
+	if *asyncOnly {
+		filters = append(filters, intento.ProviderAsyncOnly(true))
+	}
+
+	filtered := catalog.ProvidersFor(*from, *to, filters...)
+
+	sort.Slice(filtered, func(i, j int) bool { return filtered[i].ID < filtered[j].ID })
+
+	rows := make([][]string, len(filtered))
+	for i, provider := range filtered {
+		rows[i] = []string{
+			provider.ID,
+			provider.Name,
+			provider.Vendor,
+			strconv.FormatBool(provider.Production),
+			strconv.FormatBool(provider.CustomModel),
+			strconv.FormatBool(provider.AsyncOnly),
+		}
+	}
+
+	return env.out.write(filtered, []string{"ID", "NAME", "VENDOR", "PRODUCTION", "CUSTOM MODEL", "ASYNC ONLY"}, rows)
+}
+
+func runLanguages(ctx context.Context, env *environment, args []string) error {
+	flags := flag.NewFlagSet("languages", flag.ContinueOnError)
+	flags.SetOutput(env.stderr)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	languages, err := env.client.AvailableLanguages(ctx)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(languages, func(i, j int) bool { return languages[i].IntentoCode < languages[j].IntentoCode })
+
+	rows := make([][]string, len(languages))
+	for i, language := range languages {
+		rows[i] = []string{language.IntentoCode, language.ISOName, language.Direction}
+	}
+
+	return env.out.write(languages, []string{"CODE", "NAME", "DIRECTION"}, rows)
+}
+
+func runRouting(ctx context.Context, env *environment, args []string) error {
+	flags := flag.NewFlagSet("routing", flag.ContinueOnError)
+	flags.SetOutput(env.stderr)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	routing, err := env.client.SmartRoutingList(ctx)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(routing, func(i, j int) bool { return routing[i].Name < routing[j].Name })
+
+	rows := make([][]string, len(routing))
+	for i, item := range routing {
+		rows[i] = []string{item.Name, item.Description}
+	}
+
+	return env.out.write(routing, []string{"NAME", "DESCRIPTION"}, rows)
+}
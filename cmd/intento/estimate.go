@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"strconv"
+
+	"intento-golang/intento"
+)
+
+func runEstimate(ctx context.Context, env *environment, args []string) error {
+	flags := flag.NewFlagSet("estimate", flag.ContinueOnError)
+	flags.SetOutput(env.stderr)
+
+	from := flags.String("from", intento.AutoDetectSourceLanguage, "source language, any if empty")
+	to := flags.String("to", "", "target language (required)")
+	format := flags.String("format", "", "source text format, e.g. html")
+	provider := flags.String("provider", "", "provider ID, all providers of the pair if empty")
+	languageTags := flags.Bool("language-tags", false, "accept BCP-47 tags like pt-BR or locale names like pt_BR as languages")
+
+	var files stringList
+	flags.Var(&files, "file", "read text from the file, one item per line (repeatable)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if *to == "" {
+		return errors.New("estimate: -to is required")
+	}
+
+	text, err := readText(env.stdin, flags.Args(), files)
+	if err != nil {
+		return err
+	}
+
+	var options []intento.TranslationOption
+
+	if *format != "" {
+		options = append(options, intento.TranslationWithSourceTextFormat(intento.TextFormat(*format)))
+	}
+
+	if *provider != "" {
+		options = append(options, intento.TranslationWithProvider(*provider))
+	}
+
+	if *languageTags {
+		options = append(options, intento.TranslationWithLanguageTags())
+	}
+
+	estimates, err := env.client.EstimateCost(ctx, text, *from, *to, options...)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(estimates))
+	for i, estimate := range estimates {
+		rows[i] = []string{
+			estimate.ProviderID,
+			estimate.ProviderName,
+			strconv.Itoa(estimate.Characters),
+			strconv.Itoa(estimate.Price),
+			strconv.FormatFloat(estimate.Cost, 'f', 4, 64),
+		}
+	}
+
+	return env.out.write(estimates, []string{"PROVIDER", "NAME", "CHARACTERS", "PRICE", "COST"}, rows)
+}
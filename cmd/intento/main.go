@@ -0,0 +1,282 @@
+// Command intento translates text and inspects the catalog of the Intento API.
+//
+// Usage:
+//
+//	intento [global flags] <command> [command flags] [args]
+//
+// Commands:
+//
+//	translate  translate text from args, files or stdin
+//	providers  list translation providers
+//	languages  list supported languages
+//	routing    list smart routing schemes
+//	usage      show usage statistics
+//	estimate   estimate the cost of translating text
+//
+// The API key is read from the -key flag, the INTENTO_API_KEY environment
+// variable or the "api_key" field of the JSON config file, in that order.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"intento-golang/intento"
+)
+
+// command is a subcommand of the tool.
+type command struct {
+	name        string
+	description string
+	run         func(ctx context.Context, env *environment, args []string) error
+}
+
+var commands = []command{
+	{"translate", "translate text from args, files or stdin", runTranslate},
+	{"providers", "list translation providers", runProviders},
+	{"languages", "list supported languages", runLanguages},
+	{"routing", "list smart routing schemes", runRouting},
+	{"usage", "show usage statistics", runUsage},
+	{"estimate", "estimate the cost of translating text", runEstimate},
+}
+
+// environment is shared by all commands.
+type environment struct {
+	client *intento.Client
+	out    output
+	stdin  io.Reader
+	stderr io.Writer
+}
+
+// config is the content of the config file.
+type config struct {
+	APIKey         string `json:"api_key"`
+	BaseURL        string `json:"base_url"`
+	SyncWrapperURL string `json:"sync_wrapper_url"`
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "intento:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("intento", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { usage(flags) }
+
+	key := flags.String("key", "", "Intento API key")
+	configPath := flags.String("config", defaultConfigPath(), "path to the JSON config file")
+	format := flags.String("output", "table", "output format: table, json or plain")
+	retries := flags.Int("retries", 1, "maximum number of attempts per request")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		usage(flags)
+		return errors.New("command is required")
+	}
+
+	cmd, ok := findCommand(flags.Arg(0))
+	if !ok {
+		usage(flags)
+		return fmt.Errorf("unknown command %q", flags.Arg(0))
+	}
+
+	cfg, err := readConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	apiKey := firstNonEmpty(*key, os.Getenv("INTENTO_API_KEY"), cfg.APIKey)
+	if apiKey == "" {
+		return errors.New("API key is not set, use -key, INTENTO_API_KEY or the config file")
+	}
+
+	out, err := newOutput(*format, stdout)
+	if err != nil {
+		return err
+	}
+
+	options := []intento.ClientOption{
+		intento.ClientWithLogger(func(ctx context.Context, format string, args ...interface{}) {
+			fmt.Fprintf(stderr, format+"\n", args...)
+		}),
+	}
+
+	if *retries > 1 {
+		policy := intento.DefaultRetryPolicy()
+		policy.MaxAttempts = *retries
+		options = append(options, intento.ClientWithRetryPolicy(policy))
+	}
+
+	if cfg.BaseURL != "" {
+		options = append(options, intento.ClientWithBaseURL(cfg.BaseURL))
+	}
+
+	if cfg.SyncWrapperURL != "" {
+		options = append(options, intento.ClientWithSyncWrapperURL(cfg.SyncWrapperURL))
+	}
+
+	env := &environment{
+		client: intento.New(apiKey, options...),
+		out:    out,
+		stdin:  stdin,
+		stderr: stderr,
+	}
+
+	return cmd.run(ctx, env, flags.Args()[1:])
+}
+
+func usage(flags *flag.FlagSet) {
+	w := flags.Output()
+
+	fmt.Fprintln(w, "Usage: intento [global flags] <command> [command flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.description)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Global flags:")
+	flags.PrintDefaults()
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+
+	return command{}, false
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "intento", "config.json")
+}
+
+// readConfig reads the config file, a missing file is an empty config.
+func readConfig(path string) (config, error) {
+	var cfg config
+
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("read config: %w", err)
+	}
+
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		return cfg, fmt.Errorf("unmarshal config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// output writes command results in the selected format.
+type output struct {
+	format string
+	w      io.Writer
+}
+
+func newOutput(format string, w io.Writer) (output, error) {
+	switch format {
+	case "table", "json", "plain":
+		return output{format: format, w: w}, nil
+	default:
+		return output{}, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// write prints v as JSON, header and rows as a table, or the first column of
+// rows as plain text.
+func (o output) write(v interface{}, header []string, rows [][]string) error {
+	switch o.format {
+	case "json":
+		encoder := json.NewEncoder(o.w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(v)
+	case "plain":
+		for _, row := range rows {
+			_, err := fmt.Fprintln(o.w, row[0])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		tw := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, row := range rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+
+		return tw.Flush()
+	}
+}
+
+// stringList is a flag that may be repeated or hold comma-separated values.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			*l = append(*l, item)
+		}
+	}
+
+	return nil
+}
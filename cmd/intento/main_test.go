@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+// writeConfig writes a config file pointing at the server and returns its path.
+func writeConfig(t *testing.T, server *intentotest.Server, apiKey string) string {
+	data, err := json.Marshal(config{APIKey: apiKey, BaseURL: server.URL, SyncWrapperURL: server.URL})
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return path
+}
+
+func TestRun(t *testing.T) {
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetLanguages([]intento.Language{{IntentoCode: "en", ISOName: "English", Direction: "ltr"}})
+	server.SetProviders([]intento.Provider{{ID: "p1", Name: "Provider", Pairs: []intento.LanguagePair{{From: "en", To: "es"}}}})
+
+	configPath := writeConfig(t, server, intentotest.APIKey)
+
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "translate plain",
+			args: []string{"-output", "plain", "translate", "-to", "es", "Hello", "World"},
+			want: "[es] Hello\n[es] World\n",
+		},
+		{
+			name:  "translate stdin",
+			args:  []string{"-output", "plain", "translate", "-to", "es"},
+			stdin: "Hello\n\nWorld\n",
+			want:  "[es] Hello\n[es] World\n",
+		},
+		{
+			name: "translate table",
+			args: []string{"translate", "-from", "en", "-to", "es", "Hello"},
+			want: "TRANSLATION  SOURCE  FROM\n[es] Hello   Hello   en\n",
+		},
+		{
+			name: "languages table",
+			args: []string{"-output", "table", "languages"},
+			want: "CODE  NAME     DIRECTION\nen    English  ltr\n",
+		},
+		{
+			name: "providers plain",
+			args: []string{"-output", "plain", "providers", "-from", "en", "-to", "es"},
+			want: "p1\n",
+		},
+		{
+			name: "providers filtered out",
+			args: []string{"-output", "plain", "providers", "-from", "de"},
+			want: "",
+		},
+		{
+			name:    "missing target",
+			args:    []string{"translate", "Hello"},
+			wantErr: "translate: -to is required",
+		},
+		{
+			name:    "unknown flag",
+			args:    []string{"translate", "-too", "es", "Hello"},
+			wantErr: "flag provided but not defined: -too",
+		},
+		{
+			name:    "missing command",
+			args:    []string{},
+			wantErr: "command is required",
+		},
+		{
+			name:    "unknown command",
+			args:    []string{"explode"},
+			wantErr: `unknown command "explode"`,
+		},
+		{
+			name:    "unknown output",
+			args:    []string{"-output", "xml", "languages"},
+			wantErr: `unknown output format "xml"`,
+		},
+		{
+			name:    "invalid credential",
+			args:    []string{"translate", "-to", "es", "-credential", "p1", "Hello"},
+			wantErr: `translate: invalid -credential "p1", want provider_id=credential_id`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			args := append([]string{"-config", configPath}, tt.args...)
+
+			err := run(context.Background(), args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, stdout.String())
+		})
+	}
+}
+
+func TestRun_json(t *testing.T) {
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	var stdout, stderr bytes.Buffer
+
+	err := run(
+		context.Background(),
+		[]string{"-config", writeConfig(t, server, intentotest.APIKey), "-output", "json", "translate", "-to", "es", "Hello"},
+		strings.NewReader(""),
+		&stdout,
+		&stderr,
+	)
+	assert.NoError(t, err)
+
+	var result intento.TranslationResult
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &result))
+	assert.Equal(t, []string{"[es] Hello"}, result.Results)
+}
+
+func TestRun_help(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	err := run(context.Background(), []string{"-h"}, strings.NewReader(""), &stdout, &stderr)
+	assert.True(t, errors.Is(err, flag.ErrHelp))
+	assert.Contains(t, stderr.String(), "Usage: intento")
+}
+
+func TestRun_keyPrecedence(t *testing.T) {
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		flag      string
+		env       string
+		config    string
+		wantKey   string
+		wantError string
+	}{
+		{name: "flag", flag: "flag-key", env: "env-key", config: "config-key", wantKey: "flag-key"},
+		{name: "environment", env: "env-key", config: "config-key", wantKey: "env-key"},
+		{name: "config", config: "config-key", wantKey: "config-key"},
+		{name: "none", wantError: "API key is not set, use -key, INTENTO_API_KEY or the config file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.Reset()
+			t.Setenv("INTENTO_API_KEY", tt.env)
+
+			args := []string{"-config", writeConfig(t, server, tt.config)}
+			if tt.flag != "" {
+				args = append(args, "-key", tt.flag)
+			}
+
+			args = append(args, "routing")
+
+			var stdout, stderr bytes.Buffer
+
+			err := run(context.Background(), args, strings.NewReader(""), &stdout, &stderr)
+			if tt.wantError != "" {
+				assert.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			requests := server.Requests()
+			if assert.Len(t, requests, 1) {
+				assert.Equal(t, http.MethodGet, requests[0].Method)
+				assert.Equal(t, tt.wantKey, requests[0].Header.Get("apikey"))
+			}
+		})
+	}
+}
+
+func TestRun_translateExtraResults(t *testing.T) {
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetTranslateFunc(func(text []string, from, to string) []string {
+		return append([]string{"extra"}, text...)
+	})
+
+	var stdout, stderr bytes.Buffer
+
+	err := run(
+		context.Background(),
+		[]string{"-config", writeConfig(t, server, intentotest.APIKey), "-output", "plain", "translate", "-to", "es", "Hello"},
+		strings.NewReader(""),
+		&stdout,
+		&stderr,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "extra\n", stdout.String())
+}
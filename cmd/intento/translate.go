@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"intento-golang/intento"
+)
+
+func runTranslate(ctx context.Context, env *environment, args []string) error {
+	flags := flag.NewFlagSet("translate", flag.ContinueOnError)
+	flags.SetOutput(env.stderr)
+
+	from := flags.String("from", intento.AutoDetectSourceLanguage, "source language, autodetected if empty")
+	to := flags.String("to", "", "target language (required)")
+	format := flags.String("format", "", "source text format, e.g. html")
+	trace := flags.Bool("trace", false, "store the payload for the support team")
+	provider := flags.String("provider", "", "provider ID")
+	routing := flags.String("routing", "", "smart routing scheme")
+	cacheApply := flags.Bool("cache-apply", false, "pull translations from the MT cache")
+	cacheUpdate := flags.Bool("cache-update", false, "put translations to the MT cache")
+	noTranslatePrefix := flags.String("notranslate-prefix", "", "prefix of text protected from translation")
+	noTranslateSuffix := flags.String("notranslate-suffix", "", "suffix of text protected from translation")
+	noTranslateRemoveMarkup := flags.Bool("notranslate-remove-markup", false, "remove the NOTRANSLATE prefix and suffix")
+	customModel := flags.String("custom-model", "", "custom model ID of the provider")
+	async := flags.Bool("async", false, "translate in the asynchronous mode and wait for the result")
+	languageTags := flags.Bool("language-tags", false, "accept BCP-47 tags like pt-BR or locale names like pt_BR as languages")
+
+	var files, profanity, glossaries, credentials stringList
+	flags.Var(&files, "file", "read text from the file, one item per line (repeatable)")
+	flags.Var(&profanity, "profanity", "detect unwanted content types, e.g. profanity (repeatable)")
+	flags.Var(&glossaries, "glossary", "glossary ID (repeatable)")
+	flags.Var(&credentials, "credential", "stored credential as provider_id=credential_id (repeatable)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if *to == "" {
+		return errors.New("translate: -to is required")
+	}
+
+	text, err := readText(env.stdin, flags.Args(), files)
+	if err != nil {
+		return err
+	}
+
+	var options []intento.TranslationOption
+
+	if *format != "" {
+		options = append(options, intento.TranslationWithSourceTextFormat(intento.TextFormat(*format)))
+	}
+
+	if *trace {
+		options = append(options, intento.TranslationWithTrace())
+	}
+
+	if *provider != "" {
+		options = append(options, intento.TranslationWithProvider(*provider))
+	}
+
+	if *routing != "" {
+		options = append(options, intento.TranslationWithSmartRouting(*routing))
+	}
+
+	if *cacheApply || *cacheUpdate {
+		options = append(options, intento.TranslationWithCache(*cacheApply, *cacheUpdate))
+	}
+
+	if *noTranslatePrefix != "" || *noTranslateSuffix != "" {
+		options = append(options, intento.TranslationWithNoTranslateProtection(
+			*noTranslatePrefix,
+			*noTranslateSuffix,
+			*noTranslateRemoveMarkup,
+		))
+	}
+
+	if len(profanity) > 0 {
+		options = append(options, intento.TranslationWithProfanityDetection(profanity))
+	}
+
+	if len(glossaries) > 0 {
+		options = append(options, intento.TranslationWithGlossary(glossaries...))
+	}
+
+	if *languageTags {
+		options = append(options, intento.TranslationWithLanguageTags())
+	}
+
+	if *customModel != "" {
+		options = append(options, intento.TranslationWithCustomModel(*customModel))
+	}
+
+	for _, credential := range credentials {
+		parts := strings.SplitN(credential, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("translate: invalid -credential %q, want provider_id=credential_id", credential)
+		}
+
+		options = append(options, intento.TranslationWithCredentials(parts[0], intento.StoredCredential(parts[1])))
+	}
+
+	result, err := translate(ctx, env.client, text, *from, *to, *async, options)
+	if err != nil {
+		return err
+	}
+
+	count := len(result.Results)
+	if count > len(text) {
+		count = len(text)
+	}
+
+	rows := make([][]string, count)
+	for i, translation := range result.Results[:count] {
+		detected := *from
+		if i < len(result.Meta.DetectedSourceLanguage) {
+			detected = result.Meta.DetectedSourceLanguage[i]
+		}
+
+		rows[i] = []string{translation, text[i], detected}
+	}
+
+	return env.out.write(result, []string{"TRANSLATION", "SOURCE", "FROM"}, rows)
+}
+
+func translate(
+	ctx context.Context,
+	client *intento.Client,
+	text []string,
+	from string,
+	to string,
+	async bool,
+	options []intento.TranslationOption,
+) (intento.TranslationResult, error) {
+	if !async {
+		return client.Translate(ctx, text, from, to, options...)
+	}
+
+	operation, err := client.TranslateAsync(ctx, text, from, to, options...)
+	if err != nil {
+		return intento.TranslationResult{}, err
+	}
+
+	operation, err = client.Wait(ctx, operation.ID)
+	if err != nil {
+		return intento.TranslationResult{}, err
+	}
+
+	return operation.TranslationResult()
+}
+
+// readText returns the text from args, or from files, or from stdin, one item per line.
+func readText(stdin io.Reader, args []string, files []string) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	var text []string
+
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("open file: %w", err)
+		}
+
+		lines, err := readLines(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read file %s: %w", file, err)
+		}
+
+		text = append(text, lines...)
+	}
+
+	if len(files) == 0 {
+		lines, err := readLines(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("read stdin: %w", err)
+		}
+
+		text = lines
+	}
+
+	if len(text) == 0 {
+		return nil, errors.New("translate: no text to translate")
+	}
+
+	return text, nil
+}
+
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"intento-golang/intento"
+)
+
+func runUsage(ctx context.Context, env *environment, args []string) error {
+	flags := flag.NewFlagSet("usage", flag.ContinueOnError)
+	flags.SetOutput(env.stderr)
+
+	scope := flags.String("scope", string(intento.UsageScopeIntent), "group by intento or provider")
+	from := flags.String("from", "", "start of the range, YYYY-MM-DD (default: a day ago)")
+	to := flags.String("to", "", "end of the range, YYYY-MM-DD (default: now)")
+	interval := flags.String("interval", string(intento.UsageIntervalHour), "time bucket: 1hour, 1day, 1week or 1month")
+
+	var providers, intents stringList
+	flags.Var(&providers, "provider", "only the provider (repeatable)")
+	flags.Var(&intents, "intent", "only the intent (repeatable)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	options := []intento.UsageOption{
+		intento.UsageWithInterval(intento.UsageInterval(*interval)),
+	}
+
+	if *from != "" || *to != "" {
+		rangeOption, err := usageRange(*from, *to)
+		if err != nil {
+			return err
+		}
+
+		options = append(options, rangeOption)
+	}
+
+	if len(providers) > 0 {
+		options = append(options, intento.UsageWithProvider(providers...))
+	}
+
+	if len(intents) > 0 {
+		options = append(options, intento.UsageWithIntent(intents...))
+	}
+
+	report, err := env.client.Usage(ctx, intento.UsageScope(*scope), options...)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(report.Data))
+	for i, record := range report.Data {
+		rows[i] = []string{
+			record.Time().Format("2006-01-02 15:04"),
+			record.Group.Provider + record.Group.Intent,
+			strconv.Itoa(record.Metrics.Requests),
+			strconv.Itoa(record.Metrics.Items),
+			strconv.Itoa(record.Metrics.Len),
+			strconv.Itoa(record.Metrics.Errors),
+		}
+	}
+
+	return env.out.write(report, []string{"TIME", "GROUP", "REQUESTS", "ITEMS", "CHARACTERS", "ERRORS"}, rows)
+}
+
+func usageRange(from, to string) (intento.UsageOption, error) {
+	end := time.Now()
+	start := end.Add(-24 * time.Hour)
+
+	var err error
+
+	if from != "" {
+		start, err = time.ParseInLocation("2006-01-02", from, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("usage: invalid -from: %w", err)
+		}
+	}
+
+	if to != "" {
+		end, err = time.ParseInLocation("2006-01-02", to, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("usage: invalid -to: %w", err)
+		}
+	}
+
+	return intento.UsageWithRange(start, end), nil
+}
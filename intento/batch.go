@@ -0,0 +1,243 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// BatchTranslator translates large texts by splitting them into several
+// requests that are sent concurrently.
+type BatchTranslator struct {
+	batchOptions
+	translator Translator
+}
+
+// NewBatchTranslator creates an instance of BatchTranslator sending the
+// requests with given translator, usually a Client.
+func NewBatchTranslator(translator Translator, options ...BatchOption) *BatchTranslator {
+	batch := &BatchTranslator{
+		batchOptions: defaultBatchOptions(),
+		translator:   translator,
+	}
+
+	for _, opt := range options {
+		opt.apply(&batch.batchOptions)
+	}
+
+	if batch.concurrency < 1 {
+		batch.concurrency = 1
+	}
+
+	return batch
+}
+
+// BatchFailure describes a request of the batch that has failed.
+type BatchFailure struct {
+	// Indices are the positions of the failed items in the input text.
+	Indices []int
+	Err     error
+}
+
+// BatchError is returned when some requests of the batch have failed.
+type BatchError struct {
+	Failures []BatchFailure
+}
+
+func (e *BatchError) Error() string {
+	messages := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		messages = append(messages, fmt.Sprintf("items %v: %v", failure.Indices, failure.Err))
+	}
+
+	return "intento: batch translation failed: " + strings.Join(messages, "; ")
+}
+
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure.Err)
+	}
+
+	return errs
+}
+
+// As finds the first error of the failures that matches target.
+func (e *BatchError) As(target interface{}) bool {
+	return asAny(e.Unwrap(), target)
+}
+
+// Is reports whether any error of the failures matches target.
+func (e *BatchError) Is(target error) bool {
+	return isAny(e.Unwrap(), target)
+}
+
+// Translate text with given settings, the text is split into chunks bounded
+// by the item count and the total size.
+//
+// Results are reassembled in the original order. If some chunks fail, the
+// result holds the translations of the successful chunks, empty strings in
+// place of the failed ones, and the error is a *BatchError.
+func (b *BatchTranslator) Translate(
+	ctx context.Context,
+	text []string,
+	from string,
+	to string,
+	options ...TranslationOption,
+) (TranslationResult, error) {
+	chunks := b.split(text)
+
+	results := make([]TranslationResult, len(chunks))
+	errs := make([]error, len(chunks))
+
+	semaphore := make(chan struct{}, b.concurrency)
+	wg := sync.WaitGroup{}
+
+	for i, chunk := range chunks {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, chunk []int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			chunkText := make([]string, len(chunk))
+			for j, index := range chunk {
+				chunkText[j] = text[index]
+			}
+
+			results[i], errs[i] = b.translator.Translate(ctx, chunkText, from, to, options...)
+		}(i, chunk)
+	}
+
+	wg.Wait()
+
+	var result TranslationResult
+	result.Results = make([]string, len(text))
+
+	var batchError BatchError
+
+	for i, chunk := range chunks {
+		if errs[i] == nil && len(results[i].Results) != len(chunk) {
+			errs[i] = fmt.Errorf("intento: got %d results for %d items", len(results[i].Results), len(chunk))
+		}
+
+		if errs[i] != nil {
+			batchError.Failures = append(batchError.Failures, BatchFailure{Indices: chunk, Err: errs[i]})
+			continue
+		}
+
+		if result.ID == "" {
+			result.ID = results[i].ID
+			result.Service = results[i].Service
+		}
+
+		detected := results[i].Meta.DetectedSourceLanguage
+		if len(detected) == len(chunk) && result.Meta.DetectedSourceLanguage == nil {
+			result.Meta.DetectedSourceLanguage = make([]string, len(text))
+		}
+
+		for j, index := range chunk {
+			result.Results[index] = results[i].Results[j]
+
+			if len(detected) == len(chunk) {
+				result.Meta.DetectedSourceLanguage[index] = detected[j]
+			}
+		}
+	}
+
+	if len(batchError.Failures) > 0 {
+		return result, &batchError
+	}
+
+	return result, nil
+}
+
+// split returns the indices of text items grouped into chunks.
+func (b *BatchTranslator) split(text []string) [][]int {
+	var (
+		chunks [][]int
+		chunk  []int
+		size   int
+	)
+
+	for i, item := range text {
+		if len(chunk) > 0 && (len(chunk) >= b.maxItems || size+len(item) > b.maxBytes) {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			size = 0
+		}
+
+		chunk = append(chunk, i)
+		size += len(item)
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// BatchWithMaxItems sets the maximum number of text items in a request.
+func BatchWithMaxItems(maxItems int) BatchOption {
+	return newFuncBatchOption(func(o *batchOptions) {
+		o.maxItems = maxItems
+	})
+}
+
+// BatchWithMaxBytes sets the maximum total size of text items in a request,
+// an item larger than that is sent alone.
+func BatchWithMaxBytes(maxBytes int) BatchOption {
+	return newFuncBatchOption(func(o *batchOptions) {
+		o.maxBytes = maxBytes
+	})
+}
+
+// BatchWithConcurrency sets the maximum number of requests sent at once.
+func BatchWithConcurrency(concurrency int) BatchOption {
+	return newFuncBatchOption(func(o *batchOptions) {
+		o.concurrency = concurrency
+	})
+}
+
+// BatchOption configures a BatchTranslator.
+type BatchOption interface {
+	apply(*batchOptions)
+}
+
+// batchOptions configure a BatchTranslator.
+type batchOptions struct {
+	maxItems    int
+	maxBytes    int
+	concurrency int
+}
+
+func defaultBatchOptions() batchOptions {
+	return batchOptions{
+		maxItems:    100,
+		maxBytes:    64 * 1024,
+		concurrency: 4,
+	}
+}
+
+// funcBatchOption wraps a function that modifies batchOptions into an implementation of the BatchOption interface.
+type funcBatchOption struct {
+	fn func(*batchOptions)
+}
+
+func (fbo *funcBatchOption) apply(do *batchOptions) {
+	fbo.fn(do)
+}
+
+func newFuncBatchOption(fn func(*batchOptions)) *funcBatchOption {
+	return &funcBatchOption{
+		fn: fn,
+	}
+}
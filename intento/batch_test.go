@@ -0,0 +1,83 @@
+package intento_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestBatchTranslator_Translate(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var params struct {
+				Context struct {
+					Text []string `json:"text"`
+				} `json:"context"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+				return nil, err
+			}
+
+			if params.Context.Text[0] == "fail" {
+				return &http.Response{
+					StatusCode: 500,
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+
+			var result intento.TranslationResult
+			for _, item := range params.Context.Text {
+				result.Results = append(result.Results, strings.ToUpper(item))
+				result.Meta.DetectedSourceLanguage = append(result.Meta.DetectedSourceLanguage, "en")
+			}
+
+			body, err := json.Marshal(result)
+			if err != nil {
+				return nil, err
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(string(body))),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	translator := intento.NewBatchTranslator(
+		client,
+		intento.BatchWithMaxItems(2),
+		intento.BatchWithConcurrency(2),
+	)
+
+	result, err := translator.Translate(ctx, []string{"a", "b", "c", "d", "e"}, intento.AutoDetectSourceLanguage, "es")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A", "B", "C", "D", "E"}, result.Results)
+	assert.Equal(t, []string{"en", "en", "en", "en", "en"}, result.Meta.DetectedSourceLanguage)
+	assert.Len(t, mockHttpClient.DoCalls(), 3)
+
+	result, err = translator.Translate(ctx, []string{"a", "b", "fail", "d"}, "en", "es")
+
+	var batchError *intento.BatchError
+	assert.ErrorAs(t, err, &batchError)
+	assert.Len(t, batchError.Failures, 1)
+	assert.Equal(t, []int{2, 3}, batchError.Failures[0].Indices)
+	assert.Equal(t, []string{"A", "B", "", ""}, result.Results)
+
+	var internalError *intento.InternalError
+	assert.ErrorAs(t, err, &internalError)
+	assert.True(t, batchError.As(&internalError))
+
+	var notFoundError *intento.NotFoundError
+	assert.False(t, batchError.As(&notFoundError))
+}
@@ -0,0 +1,332 @@
+package intento
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache stores translations on the client side, see ClientWithCache.
+type Cache interface {
+	// Get returns the entry stored with given key.
+	Get(ctx context.Context, key string) (CacheEntry, bool, error)
+	// Set stores the entry with given key.
+	Set(ctx context.Context, key string, entry CacheEntry) error
+}
+
+// CacheEntry is a cached translation of a text item.
+type CacheEntry struct {
+	Translation            string `json:"translation"`
+	DetectedSourceLanguage string `json:"detected_source_language,omitempty"`
+	// Service is the service that has translated the item.
+	Service ServiceInfo `json:"service"`
+}
+
+// cacheKey returns the key of the text item translated with given settings,
+// every setting that changes the translation is a part of the key.
+func cacheKey(item string, params translationOptions) string {
+	data, _ := json.Marshal([]interface{}{
+		item,
+		params.Context.From,
+		params.Context.To,
+		params.Context.Format,
+		params.Context.Category,
+		params.Service.Provider,
+		params.Service.Routing,
+		params.Service.Glossary,
+		params.Service.NoTranslate,
+		params.Service.Moderation,
+		params.Service.Auth,
+	})
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// translateWithCache translates only the text items missing from the cache
+// and merges them with the cached ones in the original order. If every item
+// is cached, the result has no ID and the service of the first item.
+//
+// Failing cache calls are logged and treated as misses.
+func translateWithCache(
+	ctx context.Context,
+	cache Cache,
+	logger Logger,
+	params translationOptions,
+	translate func(ctx context.Context, params translationOptions) (TranslationResult, error),
+) (TranslationResult, error) {
+	text := params.Context.Text
+	keys := make([]string, len(text))
+	entries := make([]CacheEntry, len(text))
+
+	var misses []int
+
+	for i, item := range text {
+		keys[i] = cacheKey(item, params)
+
+		entry, ok, err := cache.Get(ctx, keys[i])
+		if err != nil {
+			logger(ctx, "get translation from cache: %v", err)
+		}
+
+		if err != nil || !ok {
+			misses = append(misses, i)
+			continue
+		}
+
+		entries[i] = entry
+	}
+
+	var result TranslationResult
+
+	if len(misses) > 0 {
+		missParams := params
+		missParams.Context.Text = make([]string, len(misses))
+		for j, i := range misses {
+			missParams.Context.Text[j] = text[i]
+		}
+
+		var err error
+
+		result, err = translate(ctx, missParams)
+		if err != nil {
+			return TranslationResult{}, err
+		}
+
+		if len(result.Results) != len(misses) {
+			return TranslationResult{}, fmt.Errorf("intento: got %d results for %d items", len(result.Results), len(misses))
+		}
+
+		detected := result.Meta.DetectedSourceLanguage
+
+		for j, i := range misses {
+			entries[i].Translation = result.Results[j]
+			entries[i].Service = result.Service
+			if len(detected) == len(misses) {
+				entries[i].DetectedSourceLanguage = detected[j]
+			}
+
+			err = cache.Set(ctx, keys[i], entries[i])
+			if err != nil {
+				logger(ctx, "set translation to cache: %v", err)
+			}
+		}
+	}
+
+	if len(misses) == 0 && len(entries) > 0 {
+		result.Service = entries[0].Service
+	}
+
+	result.Results = make([]string, len(text))
+	result.Meta.DetectedSourceLanguage = nil
+
+	for i, entry := range entries {
+		result.Results[i] = entry.Translation
+
+		if entry.DetectedSourceLanguage != "" && result.Meta.DetectedSourceLanguage == nil {
+			result.Meta.DetectedSourceLanguage = make([]string, len(text))
+		}
+	}
+
+	if result.Meta.DetectedSourceLanguage != nil {
+		for i, entry := range entries {
+			result.Meta.DetectedSourceLanguage[i] = entry.DetectedSourceLanguage
+		}
+	}
+
+	return result, nil
+}
+
+// MemoryCache is an in-memory LRU Cache with expiration.
+type MemoryCache struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	order *list.List
+	items map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key     string
+	entry   CacheEntry
+	expires time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding up to size entries for the
+// duration of ttl, zero ttl means entries do not expire.
+func NewMemoryCache(size int, ttl time.Duration) *MemoryCache {
+	return &MemoryCache{
+		size:  size,
+		ttl:   ttl,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the entry stored with given key.
+func (c *MemoryCache) Get(ctx context.Context, key string) (CacheEntry, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[key]
+	if !ok {
+		return CacheEntry{}, false, nil
+	}
+
+	item := element.Value.(*memoryCacheItem)
+	if !item.expires.IsZero() && time.Now().After(item.expires) {
+		c.order.Remove(element)
+		delete(c.items, key)
+
+		return CacheEntry{}, false, nil
+	}
+
+	c.order.MoveToFront(element)
+
+	return item.entry, true, nil
+}
+
+// Set stores the entry with given key, evicting the least recently used
+// entry if the cache is full.
+func (c *MemoryCache) Set(ctx context.Context, key string, entry CacheEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expires time.Time
+	if c.ttl > 0 {
+		expires = time.Now().Add(c.ttl)
+	}
+
+	if element, ok := c.items[key]; ok {
+		element.Value = &memoryCacheItem{key: key, entry: entry, expires: expires}
+		c.order.MoveToFront(element)
+
+		return nil
+	}
+
+	c.items[key] = c.order.PushFront(&memoryCacheItem{key: key, entry: entry, expires: expires})
+
+	for c.size > 0 && c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*memoryCacheItem).key)
+	}
+
+	return nil
+}
+
+// FileCache is a Cache storing every entry in a file of a directory, so it
+// survives restarts and can be shared by subsequent runs.
+type FileCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// NewFileCache creates a FileCache in the directory, which is created if
+// missing. Entries older than ttl are ignored, zero ttl means entries do
+// not expire.
+func NewFileCache(dir string, ttl time.Duration) (*FileCache, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("create cache directory: %w", err)
+	}
+
+	return &FileCache{
+		dir: dir,
+		ttl: ttl,
+	}, nil
+}
+
+// Get returns the entry stored with given key.
+func (c *FileCache) Get(ctx context.Context, key string) (CacheEntry, bool, error) {
+	path := c.path(key)
+
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return CacheEntry{}, false, nil
+	}
+	if err != nil {
+		return CacheEntry{}, false, fmt.Errorf("stat cache file: %w", err)
+	}
+
+	if c.ttl > 0 && time.Since(info.ModTime()) > c.ttl {
+		return CacheEntry{}, false, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return CacheEntry{}, false, fmt.Errorf("read cache file: %w", err)
+	}
+
+	var entry CacheEntry
+
+	err = json.Unmarshal(data, &entry)
+	if err != nil {
+		return CacheEntry{}, false, fmt.Errorf("unmarshal cache file: %w", err)
+	}
+
+	return entry, true, nil
+}
+
+// Set stores the entry with given key, the file is replaced atomically.
+func (c *FileCache) Set(ctx context.Context, key string, entry CacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal json: %w", err)
+	}
+
+	err = writeFileAtomic(c.path(key), data)
+	if err != nil {
+		return fmt.Errorf("write cache file: %w", err)
+	}
+
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file and renames it to path, so
+// readers never see a partially written file.
+func writeFileAtomic(path string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return fmt.Errorf("create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write file: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("rename file: %w", err)
+	}
+
+	return nil
+}
+
+// path spreads the files over subdirectories named after the key prefix.
+func (c *FileCache) path(key string) string {
+	if len(key) < 2 {
+		return filepath.Join(c.dir, key+".json")
+	}
+
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
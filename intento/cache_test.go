@@ -0,0 +1,154 @@
+package intento_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestClient_Translate_cache(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client(intento.ClientWithCache(intento.NewMemoryCache(100, time.Hour)))
+
+	result, err := client.Translate(ctx, []string{"a", "b"}, "en", "es")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[es] a", "[es] b"}, result.Results)
+
+	result, err = client.Translate(ctx, []string{"b", "c", "a"}, "en", "es")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[es] b", "[es] c", "[es] a"}, result.Results)
+
+	result, err = client.Translate(ctx, []string{"c"}, "en", "es")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[es] c"}, result.Results)
+
+	_, err = client.Translate(ctx, []string{"c"}, "en", "de")
+	assert.NoError(t, err)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 3)
+
+	var params struct {
+		Context struct {
+			Text []string `json:"text"`
+		} `json:"context"`
+	}
+	assert.NoError(t, json.Unmarshal(requests[1].Body, &params))
+	assert.Equal(t, []string{"c"}, params.Context.Text)
+}
+
+func TestClient_Translate_cacheKey(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client(intento.ClientWithCache(intento.NewMemoryCache(100, time.Hour)))
+
+	for _, removeMarkup := range []bool{true, false, true} {
+		_, err := client.Translate(
+			ctx,
+			[]string{`Hello <span class="notranslate">Friend</span>`},
+			"en",
+			"es",
+			intento.TranslationWithSourceTextFormat(intento.FormatHTML),
+			intento.TranslationWithNoTranslateProtection(`<span class="notranslate">`, "</span>", removeMarkup),
+		)
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, server.Requests(), 2, "requests differing in remove_markup do not share the cache")
+}
+
+func TestClient_Translate_cacheAuth(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client(intento.ClientWithCache(intento.NewMemoryCache(100, time.Hour)))
+
+	for _, credentialID := range []string{"c1", "c2", "c1"} {
+		_, err := client.Translate(
+			ctx,
+			[]string{"Hello"},
+			"en",
+			"es",
+			intento.TranslationWithCredentials("p1", intento.StoredCredential(credentialID)),
+		)
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, server.Requests(), 2, "requests with different credentials do not share the cache")
+}
+
+func TestClient_Translate_cacheService(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client(intento.ClientWithCache(intento.NewMemoryCache(100, time.Hour)))
+
+	for i := 0; i < 2; i++ {
+		result, err := client.Translate(ctx, []string{"Hello"}, "en", "es", intento.TranslationWithProvider("p1"))
+		assert.NoError(t, err)
+		assert.Equal(t, "p1", result.Service.Provider.ID)
+	}
+
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestMemoryCache_eviction(t *testing.T) {
+	ctx := context.Background()
+
+	cache := intento.NewMemoryCache(2, 0)
+
+	assert.NoError(t, cache.Set(ctx, "a", intento.CacheEntry{Translation: "A"}))
+	assert.NoError(t, cache.Set(ctx, "b", intento.CacheEntry{Translation: "B"}))
+
+	_, ok, _ := cache.Get(ctx, "a")
+	assert.True(t, ok)
+
+	assert.NoError(t, cache.Set(ctx, "c", intento.CacheEntry{Translation: "C"}))
+
+	_, ok, _ = cache.Get(ctx, "b")
+	assert.False(t, ok)
+
+	entry, ok, _ := cache.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, "A", entry.Translation)
+}
+
+func TestFileCache(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+
+	cache, err := intento.NewFileCache(dir, time.Hour)
+	assert.NoError(t, err)
+
+	assert.NoError(t, cache.Set(ctx, "abcdef", intento.CacheEntry{Translation: "Hola", DetectedSourceLanguage: "en"}))
+
+	cache, err = intento.NewFileCache(dir, time.Hour)
+	assert.NoError(t, err)
+
+	entry, ok, err := cache.Get(ctx, "abcdef")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, intento.CacheEntry{Translation: "Hola", DetectedSourceLanguage: "en"}, entry)
+
+	_, ok, err = cache.Get(ctx, "missing")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,222 @@
+package intento
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+)
+
+// Keys of the catalog data held by catalogCache.
+const (
+	catalogProviders = "providers"
+	catalogLanguages = "languages"
+	catalogRouting   = "routing"
+)
+
+// catalogFetchTimeout bounds a request of a catalog endpoint, which is not
+// bound to the context of any caller since several of them may wait for it.
+const catalogFetchTimeout = time.Minute
+
+// catalogCache keeps the responses of the catalog endpoints, see
+// ClientWithCatalogCache.
+type catalogCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	path    string
+	loaded  bool
+	entries map[string]*catalogEntry
+}
+
+// catalogEntry is the cached response of a catalog endpoint.
+type catalogEntry struct {
+	value   interface{}
+	fetched time.Time
+	call    *catalogCall
+}
+
+// catalogCall is a request of a catalog endpoint in flight, concurrent
+// callers wait for it instead of sending their own.
+type catalogCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// catalogCacheFile is the on-disk snapshot of the catalog.
+type catalogCacheFile struct {
+	Fetched   map[string]time.Time `json:"fetched"`
+	Providers []Provider           `json:"providers,omitempty"`
+	Languages []Language           `json:"languages,omitempty"`
+	Routing   []SmartRouting       `json:"routing,omitempty"`
+}
+
+func newCatalogCache(ttl time.Duration, path string) *catalogCache {
+	return &catalogCache{
+		ttl:     ttl,
+		path:    path,
+		entries: make(map[string]*catalogEntry),
+	}
+}
+
+// get returns the cached value of the key.
+//
+// A missing value is fetched while the caller waits, the callers waiting for
+// the same value share the request, which is not canceled when one of them
+// gives up. An expired value is returned as is and refreshed in the
+// background, failures of the refresh are logged and the stale value is kept.
+func (c *catalogCache) get(
+	ctx context.Context,
+	logger Logger,
+	key string,
+	fetch func(ctx context.Context) (interface{}, error),
+) (interface{}, error) {
+	c.mu.Lock()
+
+	if !c.loaded {
+		c.loaded = true
+		c.load(ctx, logger)
+	}
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &catalogEntry{}
+		c.entries[key] = entry
+	}
+
+	if entry.value != nil {
+		if c.ttl > 0 && time.Since(entry.fetched) >= c.ttl && entry.call == nil {
+			entry.call = &catalogCall{done: make(chan struct{})}
+			go c.fetch(logger, key, entry, fetch)
+		}
+
+		value := entry.value
+		c.mu.Unlock()
+
+		return value, nil
+	}
+
+	call := entry.call
+	if call == nil {
+		call = &catalogCall{done: make(chan struct{})}
+		entry.call = call
+		go c.fetch(logger, key, entry, fetch)
+	}
+
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch requests the value and completes the call of the entry.
+func (c *catalogCache) fetch(
+	logger Logger,
+	key string,
+	entry *catalogEntry,
+	fetch func(ctx context.Context) (interface{}, error),
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), catalogFetchTimeout)
+	defer cancel()
+
+	value, err := fetch(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	call := entry.call
+	entry.call = nil
+
+	if err != nil {
+		if entry.value != nil {
+			logger(ctx, "refresh catalog %s: %v", key, err)
+		}
+	} else {
+		entry.value = value
+		entry.fetched = time.Now()
+		c.save(ctx, logger)
+	}
+
+	call.value, call.err = value, err
+	close(call.done)
+}
+
+// load reads the snapshot file, a missing file is ignored.
+func (c *catalogCache) load(ctx context.Context, logger Logger) {
+	if c.path == "" {
+		return
+	}
+
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		logger(ctx, "read catalog snapshot: %v", err)
+		return
+	}
+
+	var file catalogCacheFile
+
+	err = json.Unmarshal(data, &file)
+	if err != nil {
+		logger(ctx, "unmarshal catalog snapshot %s: %v", c.path, err)
+		return
+	}
+
+	values := map[string]interface{}{
+		catalogProviders: file.Providers,
+		catalogLanguages: file.Languages,
+		catalogRouting:   file.Routing,
+	}
+
+	for key, fetched := range file.Fetched {
+		value, ok := values[key]
+		if ok {
+			c.entries[key] = &catalogEntry{value: value, fetched: fetched}
+		}
+	}
+}
+
+// save writes the cached values to the snapshot file.
+func (c *catalogCache) save(ctx context.Context, logger Logger) {
+	if c.path == "" {
+		return
+	}
+
+	file := catalogCacheFile{Fetched: make(map[string]time.Time, len(c.entries))}
+
+	for key, entry := range c.entries {
+		if entry.value == nil {
+			continue
+		}
+
+		file.Fetched[key] = entry.fetched
+
+		switch value := entry.value.(type) {
+		case []Provider:
+			file.Providers = value
+		case []Language:
+			file.Languages = value
+		case []SmartRouting:
+			file.Routing = value
+		}
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		logger(ctx, "marshal catalog snapshot: %v", err)
+		return
+	}
+
+	err = writeFileAtomic(c.path, data)
+	if err != nil {
+		logger(ctx, "write catalog snapshot %s: %v", c.path, err)
+	}
+}
@@ -0,0 +1,158 @@
+package intento_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func countRequests(server *intentotest.Server, method, path string) int {
+	var count int
+
+	for _, request := range server.Requests() {
+		if request.Method == method && request.Path == path {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestClientWithCatalogCache(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetProviders([]intento.Provider{{ID: "p1"}})
+
+	client := server.Client(intento.ClientWithCatalogCache(time.Hour, ""))
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			providers, err := client.AvailableProviders(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, providers, 1)
+		}()
+	}
+
+	wg.Wait()
+
+	provider, err := client.Provider(ctx, "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, "p1", provider.ID)
+
+	assert.Equal(t, 1, countRequests(server, http.MethodGet, "/ai/text/translate"))
+	assert.Equal(t, 0, countRequests(server, http.MethodGet, "/ai/text/translate/p1"))
+}
+
+func TestClientWithCatalogCache_canceledCaller(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		_, _ = w.Write([]byte(`[{"id":"p1"}]`))
+	}))
+	defer server.Close()
+
+	client := intento.New("key", intento.ClientWithSyncWrapperURL(server.URL), intento.ClientWithCatalogCache(time.Hour, ""))
+
+	canceled, cancel := context.WithCancel(context.Background())
+
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := client.AvailableProviders(canceled)
+		firstErr <- err
+	}()
+
+	<-started
+
+	secondErr := make(chan error, 1)
+	go func() {
+		providers, err := client.AvailableProviders(context.Background())
+		if err == nil && len(providers) != 1 {
+			err = assert.AnError
+		}
+		secondErr <- err
+	}()
+
+	// Let the second caller join the request in flight.
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+	close(release)
+	assert.NoError(t, <-secondErr)
+}
+
+func TestClientWithCatalogCache_refresh(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetLanguages([]intento.Language{{IntentoCode: "en"}})
+
+	client := server.Client(intento.ClientWithCatalogCache(time.Nanosecond, ""))
+
+	languages, err := client.AvailableLanguages(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.Language{{IntentoCode: "en"}}, languages)
+
+	server.SetLanguages([]intento.Language{{IntentoCode: "de"}})
+
+	languages, err = client.AvailableLanguages(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.Language{{IntentoCode: "en"}}, languages, "stale data is returned while refreshing")
+
+	assert.Eventually(t, func() bool {
+		languages, err := client.AvailableLanguages(ctx)
+		return err == nil && len(languages) == 1 && languages[0].IntentoCode == "de"
+	}, time.Second, time.Millisecond)
+}
+
+func TestClientWithCatalogCache_snapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "catalog.json")
+
+	server := intentotest.NewServer()
+
+	server.SetRouting([]intento.SmartRouting{{Name: "best"}})
+
+	routing, err := server.Client(intento.ClientWithCatalogCache(time.Hour, path)).SmartRoutingList(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.SmartRouting{{Name: "best"}}, routing)
+
+	server.Close()
+
+	offline := intento.New(
+		"key",
+		intento.ClientWithBaseURL(server.URL),
+		intento.ClientWithSyncWrapperURL(server.URL),
+		intento.ClientWithCatalogCache(time.Nanosecond, path),
+		intento.ClientWithLogger(func(ctx context.Context, format string, args ...interface{}) {}),
+	)
+
+	routing, err = offline.SmartRoutingList(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.SmartRouting{{Name: "best"}}, routing)
+
+	_, err = offline.AvailableProviders(ctx)
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -21,7 +22,8 @@ type Logger func(ctx context.Context, format string, args ...interface{})
 // Client is the client for interacting with the Intento API.
 type Client struct {
 	clientOptions
-	apiKey string
+	apiKey  string
+	catalog *catalogSnapshot
 }
 
 // New creates an instance of Client.
@@ -32,6 +34,7 @@ func New(
 	client := &Client{
 		clientOptions: defaultClientOptions(),
 		apiKey:        apiKey,
+		catalog:       &catalogSnapshot{},
 	}
 
 	for _, opt := range options {
@@ -43,36 +46,54 @@ func New(
 
 // Provider describes a translation service provider.
 type Provider struct {
-	Production           bool     `json:"production"`
-	Integrated           bool     `json:"integrated"`
-	Billable             bool     `json:"billable"`
-	OwnAuth              bool     `json:"own_auth"`
-	StockModel           bool     `json:"stock_model"`
-	CustomModel          bool     `json:"custom_model"`
-	DelegatedCredentials bool     `json:"delegated_credentials"`
-	AsyncOnly            bool     `json:"async_only"`
-	ID                   string   `json:"id"`
-	Name                 string   `json:"name"`
-	Vendor               string   `json:"vendor"`
-	Score                int      `json:"score"`
-	Price                int      `json:"price"`
-	ApiID                string   `json:"api_id"`
-	Picture              string   `json:"picture"`
-	Type                 string   `json:"type"`
-	Description          string   `json:"description"`
-	Tone                 []string `json:"tone"`
-	Symmetric            []string `json:"symmetric"`
-	Pairs                []struct {
-		From string `json:"from"`
-		To   string `json:"to"`
-	} `json:"pairs"`
+	Production           bool           `json:"production"`
+	Integrated           bool           `json:"integrated"`
+	Billable             bool           `json:"billable"`
+	OwnAuth              bool           `json:"own_auth"`
+	StockModel           bool           `json:"stock_model"`
+	CustomModel          bool           `json:"custom_model"`
+	DelegatedCredentials bool           `json:"delegated_credentials"`
+	AsyncOnly            bool           `json:"async_only"`
+	ID                   string         `json:"id"`
+	Name                 string         `json:"name"`
+	Vendor               string         `json:"vendor"`
+	Score                int            `json:"score"`
+	Price                int            `json:"price"`
+	ApiID                string         `json:"api_id"`
+	Picture              string         `json:"picture"`
+	Type                 string         `json:"type"`
+	Description          string         `json:"description"`
+	Tone                 []string       `json:"tone"`
+	Symmetric            []string       `json:"symmetric"`
+	Pairs                []LanguagePair `json:"pairs"`
+}
+
+// LanguagePair describes a translation direction.
+type LanguagePair struct {
+	From string `json:"from"`
+	To   string `json:"to"`
 }
 
 // AvailableProviders returns the list of available providers.
 func (c *Client) AvailableProviders(ctx context.Context) ([]Provider, error) {
+	if c.catalogCache != nil {
+		value, err := c.catalogCache.get(ctx, c.logger, catalogProviders, func(ctx context.Context) (interface{}, error) {
+			return c.availableProviders(ctx)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]Provider(nil), value.([]Provider)...), nil
+	}
+
+	return c.availableProviders(ctx)
+}
+
+func (c *Client) availableProviders(ctx context.Context) ([]Provider, error) {
 	var providers []Provider
 
-	err := c.apiGetRequest(ctx, "https://syncwrapper.inten.to/ai/text/translate", &providers)
+	err := c.apiGetRequest(ctx, c.url(endpointProviders), &providers)
 	if err != nil {
 		return nil, err
 	}
@@ -86,11 +107,54 @@ type Language struct {
 	ISOName     string `json:"iso_name"`
 }
 
+// Provider returns the translation provider with given ID.
+//
+// With ClientWithCatalogCache, the provider is looked up in the cached list
+// of providers first.
+func (c *Client) Provider(ctx context.Context, id string) (Provider, error) {
+	if c.catalogCache != nil {
+		providers, err := c.AvailableProviders(ctx)
+		if err != nil {
+			return Provider{}, err
+		}
+
+		for _, provider := range providers {
+			if provider.ID == id {
+				return provider, nil
+			}
+		}
+	}
+
+	var provider Provider
+
+	err := c.apiGetRequest(ctx, c.url(endpointProvider, id), &provider)
+	if err != nil {
+		return Provider{}, err
+	}
+
+	return provider, nil
+}
+
 // AvailableLanguages returns the list of available languages.
 func (c *Client) AvailableLanguages(ctx context.Context) ([]Language, error) {
+	if c.catalogCache != nil {
+		value, err := c.catalogCache.get(ctx, c.logger, catalogLanguages, func(ctx context.Context) (interface{}, error) {
+			return c.availableLanguages(ctx)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]Language(nil), value.([]Language)...), nil
+	}
+
+	return c.availableLanguages(ctx)
+}
+
+func (c *Client) availableLanguages(ctx context.Context) ([]Language, error) {
 	var languages []Language
 
-	err := c.apiGetRequest(ctx, "https://syncwrapper.inten.to/ai/text/translate/languages", &languages)
+	err := c.apiGetRequest(ctx, c.url(endpointLanguages), &languages)
 	if err != nil {
 		return nil, err
 	}
@@ -105,11 +169,26 @@ type SmartRouting struct {
 
 // SmartRoutingList returns the list of available smart routing.
 func (c *Client) SmartRoutingList(ctx context.Context) ([]SmartRouting, error) {
+	if c.catalogCache != nil {
+		value, err := c.catalogCache.get(ctx, c.logger, catalogRouting, func(ctx context.Context) (interface{}, error) {
+			return c.smartRoutingList(ctx)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]SmartRouting(nil), value.([]SmartRouting)...), nil
+	}
+
+	return c.smartRoutingList(ctx)
+}
+
+func (c *Client) smartRoutingList(ctx context.Context) ([]SmartRouting, error) {
 	var response struct {
 		Routing []SmartRouting `json:"routing"`
 	}
 
-	err := c.apiGetRequest(ctx, "https://api.inten.to/ai/text/translate/routing", &response)
+	err := c.apiGetRequest(ctx, c.url(endpointRouting), &response)
 	if err != nil {
 		return nil, err
 	}
@@ -126,18 +205,25 @@ type TranslationResult struct {
 	Meta    struct {
 		DetectedSourceLanguage []string `json:"detected_source_language"`
 	} `json:"meta"`
-	Service struct {
-		Provider struct {
-			ID          string `json:"id"`
-			Name        string `json:"name"`
-			Vendor      string `json:"vendor"`
-			Description string `json:"description"`
-			Logo        string `json:"logo"`
-		} `json:"provider"`
-	} `json:"service"`
+	Service ServiceInfo `json:"service"`
+}
+
+// ServiceInfo describes the service that has fulfilled an intent.
+type ServiceInfo struct {
+	Provider struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Vendor      string `json:"vendor"`
+		Description string `json:"description"`
+		Logo        string `json:"logo"`
+	} `json:"provider"`
 }
 
 // Translate text with given settings.
+//
+// If the client has a cache, see ClientWithCache, only the text items
+// missing from the cache are sent. The language tags are resolved and the
+// request is checked before the cache is looked up.
 func (c *Client) Translate(
 	ctx context.Context,
 	text []string,
@@ -145,18 +231,30 @@ func (c *Client) Translate(
 	to string,
 	options ...TranslationOption,
 ) (TranslationResult, error) {
-	params := translationOptions{}
-	params.Context.Text = text
-	params.Context.From = from
-	params.Context.To = to
+	params := newTranslationOptions(text, from, to, options)
 
-	for _, opt := range options {
-		opt.apply(&params)
+	err := c.prepareTranslation(ctx, &params)
+	if err != nil {
+		return TranslationResult{}, err
+	}
+
+	if c.cache != nil {
+		return translateWithCache(ctx, c.cache, c.logger, params, c.translate)
+	}
+
+	return c.translate(ctx, params)
+}
+
+// translate sends the prepared translation request.
+func (c *Client) translate(ctx context.Context, params translationOptions) (TranslationResult, error) {
+	err := c.rateLimiter.waitCharacters(ctx, params.Context.Text)
+	if err != nil {
+		return TranslationResult{}, fmt.Errorf("wait for rate limit: %w", err)
 	}
 
 	var result TranslationResult
 
-	err := c.apiPostRequest(ctx, "https://syncwrapper.inten.to/ai/text/translate", &params, &result)
+	err = c.apiPostRequest(ctx, c.url(endpointTranslate), &params, &result)
 	if err != nil {
 		return TranslationResult{}, err
 	}
@@ -164,36 +262,155 @@ func (c *Client) Translate(
 	return result, nil
 }
 
-func (c *Client) apiGetRequest(ctx context.Context, url string, result interface{}) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+// TranslateAsync submits text for translation in the asynchronous mode.
+//
+// The returned Operation only carries the operation ID, use Wait to poll it
+// until the translation is done and Operation.TranslationResult to read it.
+func (c *Client) TranslateAsync(
+	ctx context.Context,
+	text []string,
+	from string,
+	to string,
+	options ...TranslationOption,
+) (Operation, error) {
+	params := newTranslationOptions(text, from, to, options)
+	params.Service.Async = true
+
+	err := c.prepareTranslation(ctx, &params)
 	if err != nil {
-		return fmt.Errorf("create http request: %w", err)
+		return Operation{}, err
+	}
+
+	err = c.rateLimiter.waitCharacters(ctx, params.Context.Text)
+	if err != nil {
+		return Operation{}, fmt.Errorf("wait for rate limit: %w", err)
+	}
+
+	var operation Operation
+
+	err = c.apiCreateRequest(ctx, c.url(endpointTranslateAsync), &params, &operation)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	return operation, nil
+}
+
+func newTranslationOptions(text []string, from, to string, options []TranslationOption) translationOptions {
+	params := translationOptions{}
+	params.Context.Text = text
+	params.Context.From = from
+	params.Context.To = to
+
+	for _, opt := range options {
+		opt.applyTranslation(&params)
+	}
+
+	return params
+}
+
+// prepareTranslation resolves the language tags and checks the translation
+// request.
+func (c *Client) prepareTranslation(ctx context.Context, params *translationOptions) error {
+	if params.languageTags {
+		err := c.resolveLanguageTags(ctx, params)
+		if err != nil {
+			return err
+		}
 	}
 
-	return c.apiRequest(ctx, req, result)
+	if c.validation {
+		// The validation covers the custom model as well.
+		err := c.validateTranslation(ctx, *params)
+		if err != nil {
+			return err
+		}
+	} else if params.Context.Category != "" {
+		err := c.checkCustomModel(ctx, params.Service.Provider)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) apiGetRequest(ctx context.Context, url string, result interface{}) error {
+	return c.apiRequest(ctx, http.MethodGet, url, nil, true, result)
+}
+
+func (c *Client) apiDeleteRequest(ctx context.Context, url string, result interface{}) error {
+	return c.apiRequest(ctx, http.MethodDelete, url, nil, true, result)
 }
 
+// apiPostRequest sends a POST request that can be repeated without side
+// effects, like a translation.
 func (c *Client) apiPostRequest(ctx context.Context, url string, params interface{}, result interface{}) error {
 	requestBody, err := json.Marshal(params)
 	if err != nil {
 		return fmt.Errorf("marshal json: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(requestBody))
+	return c.apiRequest(ctx, http.MethodPost, url, requestBody, true, result)
+}
+
+// apiCreateRequest sends a POST request that creates a resource or an
+// operation, it is not repeated after a transport error since the first
+// attempt may have reached the server.
+func (c *Client) apiCreateRequest(ctx context.Context, url string, params interface{}, result interface{}) error {
+	requestBody, err := json.Marshal(params)
 	if err != nil {
-		return fmt.Errorf("create http request: %w", err)
+		return fmt.Errorf("marshal json: %w", err)
 	}
 
-	return c.apiRequest(ctx, req, result)
+	return c.apiRequest(ctx, http.MethodPost, url, requestBody, false, result)
+}
+
+// apiRequest sends the request, retrying it according to the retry policy.
+func (c *Client) apiRequest(ctx context.Context, method, url string, body []byte, idempotent bool, result interface{}) error {
+	for attempt := 1; ; attempt++ {
+		err := c.apiAttempt(ctx, method, url, body, idempotent, result)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= c.retryPolicy.MaxAttempts || ctx.Err() != nil || !c.retryPolicy.retriable(err) {
+			return err
+		}
+
+		delay := c.retryPolicy.delay(attempt, err)
+		c.logger(ctx, "retry %s %s in %v after attempt %d: %v", method, url, delay, attempt, err)
+
+		err = sleepContext(ctx, delay)
+		if err != nil {
+			return fmt.Errorf("wait for retry: %w", err)
+		}
+	}
 }
 
-func (c *Client) apiRequest(ctx context.Context, req *http.Request, result interface{}) error {
+// apiAttempt sends the request once, the request is re-created from body on every call.
+func (c *Client) apiAttempt(ctx context.Context, method, url string, body []byte, idempotent bool, result interface{}) error {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	err := c.rateLimiter.waitRequest(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for rate limit: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return fmt.Errorf("create http request: %w", err)
+	}
+
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("apikey", c.apiKey)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("http do request: %w", err)
+		return fmt.Errorf("http do request: %w", &TransportError{Err: err, Idempotent: idempotent})
 	}
 	defer func() {
 		err := resp.Body.Close()
@@ -202,11 +419,15 @@ func (c *Client) apiRequest(ctx context.Context, req *http.Request, result inter
 		}
 	}()
 
-	err = httpStatusCodeToError(resp.StatusCode)
+	err = httpResponseToError(req, resp)
 	if err != nil {
 		return fmt.Errorf("check http status code: %w", err)
 	}
 
+	if result == nil {
+		return nil
+	}
+
 	err = json.NewDecoder(resp.Body).Decode(result)
 	if err != nil {
 		return fmt.Errorf("unmarshal response json: %w", err)
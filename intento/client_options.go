@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"time"
 )
 
 // ClientWithHttpClient sets HttpClient.
@@ -20,6 +21,87 @@ func ClientWithLogger(logger Logger) ClientOption {
 	})
 }
 
+// ClientWithBaseURL sets the base URL of the Intento API.
+//
+// It may point at a regional endpoint, a proxy or a local stand-in server.
+// The default is DefaultBaseURL.
+func ClientWithBaseURL(baseURL string) ClientOption {
+	return newFuncClientOption(func(o *clientOptions) {
+		o.baseURL = baseURL
+	})
+}
+
+// ClientWithSyncWrapperURL sets the base URL of the Intento synchronous wrapper.
+//
+// The default is DefaultSyncWrapperURL.
+func ClientWithSyncWrapperURL(syncWrapperURL string) ClientOption {
+	return newFuncClientOption(func(o *clientOptions) {
+		o.syncWrapperURL = syncWrapperURL
+	})
+}
+
+// ClientWithRetryPolicy sets the policy of retrying failed requests.
+//
+// By default, every request is sent only once.
+func ClientWithRetryPolicy(policy RetryPolicy) ClientOption {
+	return newFuncClientOption(func(o *clientOptions) {
+		o.retryPolicy = policy
+	})
+}
+
+// ClientWithRateLimit limits the rate of requests sent by the Client.
+//
+// The limiter is a token bucket shared by all methods of the Client: callers
+// block until capacity is available or their context is done. The number of
+// characters is counted from the translated text. Zero disables the limit.
+func ClientWithRateLimit(requestsPerSecond float64, charactersPerMinute int) ClientOption {
+	return newFuncClientOption(func(o *clientOptions) {
+		o.rateLimiter = newRateLimiter(requestsPerSecond, charactersPerMinute)
+	})
+}
+
+// ClientWithCache sets the client-side translation cache.
+//
+// Unlike TranslationWithCache, which controls the MT cache on the Intento
+// side, the client-side cache is consulted before the request is sent, so
+// cached text items are neither sent nor billed. See NewMemoryCache and
+// NewFileCache.
+//
+// When every text item is cached, no request is sent: the ID of the result is
+// empty and its service is the one that translated the first item.
+func ClientWithCache(cache Cache) ClientOption {
+	return newFuncClientOption(func(o *clientOptions) {
+		o.cache = cache
+	})
+}
+
+// ClientWithValidation checks every translation request against the catalog
+// of providers and languages before it is sent, see Client.ValidateTranslation.
+//
+// The catalog is requested once and reused by subsequent requests, unless
+// ClientWithCatalogCache keeps it fresh.
+func ClientWithValidation() ClientOption {
+	return newFuncClientOption(func(o *clientOptions) {
+		o.validation = true
+	})
+}
+
+// ClientWithCatalogCache caches the responses of AvailableProviders,
+// AvailableLanguages and SmartRoutingList.
+//
+// Cached data older than ttl is still returned while it is refreshed in the
+// background, concurrent requests of the same data are sent only once. Zero
+// ttl means the data is never refreshed.
+//
+// If snapshotPath is not empty, the cached data is stored in that file and
+// read from it when the Client is first used, so a service can start with the
+// last known catalog while the API is unreachable.
+func ClientWithCatalogCache(ttl time.Duration, snapshotPath string) ClientOption {
+	return newFuncClientOption(func(o *clientOptions) {
+		o.catalogCache = newCatalogCache(ttl, snapshotPath)
+	})
+}
+
 // ClientOption configures how we set up the connection.
 type ClientOption interface {
 	apply(*clientOptions)
@@ -27,14 +109,29 @@ type ClientOption interface {
 
 // clientOptions configure a Client.
 type clientOptions struct {
-	httpClient HttpClient
-	logger     Logger
+	httpClient     HttpClient
+	logger         Logger
+	baseURL        string
+	syncWrapperURL string
+	retryPolicy    RetryPolicy
+	rateLimiter    *rateLimiter
+	cache          Cache
+	validation     bool
+	catalogCache   *catalogCache
+}
+
+// defaultLogger writes messages with the standard logger.
+func defaultLogger(ctx context.Context, format string, args ...interface{}) {
+	log.Printf(format, args...)
 }
 
 func defaultClientOptions() clientOptions {
 	return clientOptions{
-		httpClient: http.DefaultClient,
-		logger:     func(ctx context.Context, format string, args ...interface{}) { log.Printf(format, args...) },
+		httpClient:     http.DefaultClient,
+		logger:         defaultLogger,
+		baseURL:        DefaultBaseURL,
+		syncWrapperURL: DefaultSyncWrapperURL,
+		retryPolicy:    RetryPolicy{MaxAttempts: 1},
 	}
 }
 
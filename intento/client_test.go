@@ -197,7 +197,7 @@ func TestClient_AvailableProviders(t *testing.T) {
 	}
 
 	mockLogger := func(ctx context.Context, format string, args ...interface{}) {
-		t.Logf(format, args)
+		t.Logf(format, args...)
 	}
 
 	const (
@@ -216,6 +216,36 @@ func TestClient_AvailableProviders(t *testing.T) {
 	assert.NotEmpty(t, providers)
 }
 
+func TestClient_baseURLs(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader("{}")),
+			}, nil
+		},
+	}
+
+	client := intento.New(
+		"api_key_1",
+		intento.ClientWithHttpClient(mockHttpClient),
+		intento.ClientWithBaseURL("http://localhost:8080/"),
+		intento.ClientWithSyncWrapperURL("http://localhost:8081"),
+	)
+
+	_, err := client.SmartRoutingList(ctx)
+	assert.NoError(t, err)
+
+	_, err = client.Translate(ctx, text, "en", "es")
+	assert.NoError(t, err)
+
+	calls := mockHttpClient.DoCalls()
+	assert.Equal(t, "http://localhost:8080/ai/text/translate/routing", calls[0].Req.URL.String())
+	assert.Equal(t, "http://localhost:8081/ai/text/translate", calls[1].Req.URL.String())
+}
+
 func readApiKey() string {
 	data, err := ioutil.ReadFile("../api_key.txt")
 	if err != nil {
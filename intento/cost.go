@@ -0,0 +1,131 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// CostEstimate is the estimated cost of a translation by a provider.
+type CostEstimate struct {
+	ProviderID   string
+	ProviderName string
+	// Characters is the number of billable characters.
+	Characters int
+	// Price is the price of the provider as reported by the API.
+	Price int
+	// Cost is Characters multiplied by Price per million characters.
+	Cost float64
+}
+
+// EstimateCost estimates the cost of a translation with given settings
+// without sending it.
+//
+// Characters are counted the way Intento bills them: every character of the
+// text items, without the markup for FormatHTML. The price of a provider is
+// assumed to be per million characters.
+//
+// The result holds the estimate of the provider set with
+// TranslationWithProvider, or else of every provider that translates the
+// pair, since any of them may be chosen by the smart routing. The estimates
+// are sorted from the cheapest.
+func (c *Client) EstimateCost(
+	ctx context.Context,
+	text []string,
+	from string,
+	to string,
+	options ...TranslationOption,
+) ([]CostEstimate, error) {
+	params := newTranslationOptions(text, from, to, options)
+
+	if params.languageTags {
+		err := c.resolveLanguageTags(ctx, &params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	catalog, err := c.catalog.providerCatalog(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("get catalog: %w", err)
+	}
+
+	var providers []Provider
+
+	if params.Service.Provider != "" {
+		provider, ok := catalog.Provider(params.Service.Provider)
+		if !ok {
+			return nil, newUnknownProviderError(params.Service.Provider)
+		}
+
+		providers = []Provider{provider}
+	} else {
+		providers = catalog.ProvidersFor(params.Context.From, params.Context.To)
+	}
+
+	characters := countBillableCharacters(params.Context.Text, params.Context.Format)
+
+	estimates := make([]CostEstimate, len(providers))
+	for i, provider := range providers {
+		estimates[i] = CostEstimate{
+			ProviderID:   provider.ID,
+			ProviderName: provider.Name,
+			Characters:   characters,
+			Price:        provider.Price,
+			Cost:         float64(characters) * float64(provider.Price) / 1e6,
+		}
+	}
+
+	sort.SliceStable(estimates, func(i, j int) bool {
+		return estimates[i].Cost < estimates[j].Cost
+	})
+
+	return estimates, nil
+}
+
+// countBillableCharacters counts the characters of the text, the markup of
+// HTML is not billed.
+func countBillableCharacters(text []string, format TextFormat) int {
+	if format != FormatHTML {
+		return countCharacters(text)
+	}
+
+	stripped := make([]string, len(text))
+	for i, item := range text {
+		stripped[i] = stripHTML(item)
+	}
+
+	return countCharacters(stripped)
+}
+
+// stripHTML removes the tags and comments of HTML and decodes its entities.
+func stripHTML(s string) string {
+	var b strings.Builder
+
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			b.WriteString(s)
+			break
+		}
+
+		b.WriteString(s[:i])
+		s = s[i:]
+
+		end := ">"
+		if strings.HasPrefix(s, "<!--") {
+			end = "-->"
+		}
+
+		j := strings.Index(s, end)
+		if j < 0 {
+			break
+		}
+
+		s = s[j+len(end):]
+	}
+
+	return html.UnescapeString(b.String())
+}
@@ -0,0 +1,53 @@
+package intento_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestClient_EstimateCost(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetProviders(testProviders())
+
+	client := server.Client()
+
+	estimates, err := client.EstimateCost(ctx, []string{"Hello", "world"}, "en", "de")
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.CostEstimate{
+		{ProviderID: "p2", Characters: 10, Price: 10, Cost: 0.0001},
+		{ProviderID: "p3", Characters: 10, Price: 10, Cost: 0.0001},
+		{ProviderID: "p1", Characters: 10, Price: 20, Cost: 0.0002},
+	}, estimates)
+
+	estimates, err = client.EstimateCost(
+		ctx,
+		[]string{`<p class="x">Fish &amp; chips</p><!-- <b>note</b> -->`},
+		"en",
+		"fr",
+		intento.TranslationWithSourceTextFormat(intento.FormatHTML),
+		intento.TranslationWithProvider("p1"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.CostEstimate{
+		{ProviderID: "p1", Characters: 12, Price: 20, Cost: 0.00024},
+	}, estimates)
+
+	_, err = client.EstimateCost(ctx, []string{"Hello"}, "en", "de", intento.TranslationWithProvider("p9"))
+	assert.ErrorAs(t, err, new(*intento.NotFoundError))
+	assert.EqualError(t, err, `intento: intent/provider not found: unknown provider "p9"`)
+
+	estimates, err = client.EstimateCost(ctx, []string{"Hello"}, "fr", "es")
+	assert.NoError(t, err)
+	assert.Empty(t, estimates)
+
+	assert.Len(t, server.Requests(), 1, "the providers are requested once")
+}
@@ -0,0 +1,81 @@
+package intento
+
+import "context"
+
+// ProviderAuth is an entry of provider authentication, its fields are
+// specific to the provider.
+type ProviderAuth map[string]interface{}
+
+// StoredCredential refers to delegated credentials stored on the Intento side.
+func StoredCredential(credentialID string) ProviderAuth {
+	return ProviderAuth{"credential_id": credentialID}
+}
+
+// DelegatedCredential describes provider credentials stored on the Intento side.
+type DelegatedCredential struct {
+	ID   string `json:"credential_id"`
+	Type string `json:"credential_type"`
+}
+
+// DelegatedCredentialParams describes the delegated credentials to add.
+type DelegatedCredentialParams struct {
+	ID     string       `json:"credential_id"`
+	Type   string       `json:"credential_type"`
+	Secret ProviderAuth `json:"secret_credentials"`
+}
+
+// DelegatedCredentialStatus describes whether the provider accepts the delegated credentials.
+type DelegatedCredentialStatus struct {
+	ID      string `json:"credential_id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// DelegatedCredentials returns the list of delegated credentials.
+func (c *Client) DelegatedCredentials(ctx context.Context) ([]DelegatedCredential, error) {
+	var response struct {
+		Data []DelegatedCredential `json:"data"`
+	}
+
+	err := c.apiGetRequest(ctx, c.url(endpointCredentials), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// AddDelegatedCredential stores provider credentials on the Intento side.
+//
+// The stored credentials are used in translations with
+// TranslationWithCredentials and StoredCredential.
+func (c *Client) AddDelegatedCredential(
+	ctx context.Context,
+	params DelegatedCredentialParams,
+) (DelegatedCredential, error) {
+	var credential DelegatedCredential
+
+	err := c.apiCreateRequest(ctx, c.url(endpointCredentials), &params, &credential)
+	if err != nil {
+		return DelegatedCredential{}, err
+	}
+
+	return credential, nil
+}
+
+// DeleteDelegatedCredential deletes the delegated credentials with given ID.
+func (c *Client) DeleteDelegatedCredential(ctx context.Context, id string) error {
+	return c.apiDeleteRequest(ctx, c.url(endpointCredential, id), nil)
+}
+
+// DelegatedCredentialStatus checks the delegated credentials with given ID.
+func (c *Client) DelegatedCredentialStatus(ctx context.Context, id string) (DelegatedCredentialStatus, error) {
+	var status DelegatedCredentialStatus
+
+	err := c.apiGetRequest(ctx, c.url(endpointCredentialStatus, id), &status)
+	if err != nil {
+		return DelegatedCredentialStatus{}, err
+	}
+
+	return status, nil
+}
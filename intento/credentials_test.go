@@ -0,0 +1,49 @@
+package intento_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_Translate_credentials(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`{"results":["Hola"]}`)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	const providerID = "ai.text.translate.google.translate_api.v3"
+
+	_, err := client.Translate(
+		ctx,
+		text,
+		"en",
+		"es",
+		intento.TranslationWithProvider(providerID),
+		intento.TranslationWithCredentials(providerID, intento.StoredCredential("cred-1")),
+		intento.TranslationWithCredentials(providerID, intento.ProviderAuth{"key": "secret"}),
+	)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(mockHttpClient.DoCalls()[0].Req.Body)
+	assert.NoError(t, err)
+	assert.Contains(
+		t,
+		string(body),
+		`"auth":{"ai.text.translate.google.translate_api.v3":[{"credential_id":"cred-1"},{"key":"secret"}]}`,
+	)
+}
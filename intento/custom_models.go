@@ -0,0 +1,55 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CustomModel describes a custom model trained with a provider.
+type CustomModel struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Pairs       []LanguagePair `json:"pairs"`
+}
+
+// CustomModels returns the list of custom models of the provider.
+func (c *Client) CustomModels(ctx context.Context, providerID string) ([]CustomModel, error) {
+	var response struct {
+		Data []CustomModel `json:"data"`
+	}
+
+	query := url.Values{"provider": []string{providerID}}
+
+	err := c.apiGetRequest(ctx, c.url(endpointCustomModels)+"?"+query.Encode(), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// checkCustomModel checks that the provider advertises custom model support,
+// the providers are requested once per Client, see catalogSnapshot.
+func (c *Client) checkCustomModel(ctx context.Context, providerID string) error {
+	if providerID == "" {
+		return &CustomModelNotSupportedError{}
+	}
+
+	catalog, err := c.catalog.providerCatalog(ctx, c)
+	if err != nil {
+		return fmt.Errorf("get catalog: %w", err)
+	}
+
+	provider, ok := catalog.Provider(providerID)
+	if !ok {
+		return newUnknownProviderError(providerID)
+	}
+
+	if !provider.CustomModel {
+		return &CustomModelNotSupportedError{ProviderID: providerID}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package intento_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_Translate_customModel(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body := `{"results":["Hola"]}`
+			if req.Method == http.MethodGet {
+				body = `[{"id":"p1","custom_model":true}]`
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Translate(
+			ctx,
+			text,
+			"en",
+			"es",
+			intento.TranslationWithProvider("p1"),
+			intento.TranslationWithCustomModel("model-1"),
+		)
+		assert.NoError(t, err)
+	}
+
+	calls := mockHttpClient.DoCalls()
+	assert.Len(t, calls, 3, "the providers are requested once")
+	assert.Equal(t, http.MethodGet, calls[0].Req.Method)
+	assert.Equal(t, "/ai/text/translate", calls[0].Req.URL.Path)
+
+	body, err := io.ReadAll(calls[1].Req.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"category":"model-1"`)
+}
+
+func TestClient_Translate_customModelNotSupported(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`[{"id":"p1","custom_model":false}]`)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	_, err := client.Translate(
+		ctx,
+		text,
+		"en",
+		"es",
+		intento.TranslationWithProvider("p1"),
+		intento.TranslationWithCustomModel("model-1"),
+	)
+
+	var customModelNotSupportedError *intento.CustomModelNotSupportedError
+	assert.ErrorAs(t, err, &customModelNotSupportedError)
+	assert.Equal(t, "p1", customModelNotSupportedError.ProviderID)
+	assert.Len(t, mockHttpClient.DoCalls(), 1)
+
+	_, err = client.Translate(
+		ctx,
+		text,
+		"en",
+		"es",
+		intento.TranslationWithProvider("p9"),
+		intento.TranslationWithCustomModel("model-1"),
+	)
+
+	var notFoundError *intento.NotFoundError
+	assert.ErrorAs(t, err, &notFoundError)
+	assert.EqualError(t, err, `intento: intent/provider not found: unknown provider "p9"`)
+	assert.Len(t, mockHttpClient.DoCalls(), 1)
+}
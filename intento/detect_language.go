@@ -0,0 +1,89 @@
+package intento
+
+import "context"
+
+// LanguageCandidate is a language the text may be written in.
+type LanguageCandidate struct {
+	Language   string  `json:"language"`
+	Confidence float64 `json:"confidence"`
+}
+
+// DetectionResult describes a result of language detection.
+type DetectionResult struct {
+	ID string `json:"id"`
+	// Results holds the candidates for every text item, the most probable first.
+	Results [][]LanguageCandidate `json:"results"`
+	Service ServiceInfo           `json:"service"`
+}
+
+// DetectLanguage detects the language of every text item.
+func (c *Client) DetectLanguage(
+	ctx context.Context,
+	text []string,
+	options ...DetectionOption,
+) (DetectionResult, error) {
+	params := detectionOptions{}
+	params.Context.Text = text
+
+	for _, opt := range options {
+		opt.applyDetection(&params)
+	}
+
+	var result DetectionResult
+
+	err := c.intentRequest(ctx, c.url(endpointDetectLanguage), text, false, &params, &result)
+	if err != nil {
+		return DetectionResult{}, err
+	}
+
+	return result, nil
+}
+
+// DetectionWithProvider sets a language detection provider.
+func DetectionWithProvider(providerID string) DetectionOption {
+	return newFuncDetectionOption(func(o *detectionOptions) {
+		o.Service.Provider = providerID
+	})
+}
+
+// DetectionWithSmartRouting sets a smart routing, see TranslationWithSmartRouting.
+func DetectionWithSmartRouting(routing string) DetectionOption {
+	return newFuncDetectionOption(func(o *detectionOptions) {
+		o.Service.Routing = routing
+	})
+}
+
+// DetectionWithTrace sets trace flag, see TranslationWithTrace.
+func DetectionWithTrace() DetectionOption {
+	return newFuncDetectionOption(func(o *detectionOptions) {
+		o.Service.Trace = true
+	})
+}
+
+// DetectionOption configures how we detect a language.
+type DetectionOption interface {
+	applyDetection(*detectionOptions)
+}
+
+// detectionOptions configure a language detection process.
+type detectionOptions struct {
+	Context struct {
+		Text []string `json:"text,omitempty"`
+	} `json:"context"`
+	Service serviceOptions `json:"service"`
+}
+
+// funcDetectionOption wraps a function that modifies detectionOptions into an implementation of the DetectionOption interface.
+type funcDetectionOption struct {
+	fn func(*detectionOptions)
+}
+
+func (fdo *funcDetectionOption) applyDetection(do *detectionOptions) {
+	fdo.fn(do)
+}
+
+func newFuncDetectionOption(fn func(*detectionOptions)) *funcDetectionOption {
+	return &funcDetectionOption{
+		fn: fn,
+	}
+}
@@ -0,0 +1,42 @@
+package intento_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestClient_DetectLanguage(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.Respond(http.MethodPost, "/ai/text/detect-language", intentotest.Response{
+		Body: `{"results":[[{"language":"es","confidence":0.9},{"language":"pt","confidence":0.1}]],"service":{"provider":{"id":"p1"}}}`,
+	})
+
+	client := server.Client()
+
+	result, err := client.DetectLanguage(
+		ctx,
+		[]string{"Hola"},
+		intento.DetectionWithProvider("p1"),
+		intento.DetectionWithTrace(),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]intento.LanguageCandidate{{{Language: "es", Confidence: 0.9}, {Language: "pt", Confidence: 0.1}}}, result.Results)
+	assert.Equal(t, "p1", result.Service.Provider.ID)
+
+	assert.JSONEq(
+		t,
+		`{"context":{"text":["Hola"]},"service":{"provider":"p1","trace":true}}`,
+		string(server.Requests()[0].Body),
+	)
+}
@@ -0,0 +1,203 @@
+package intento
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// DictionaryResult describes the dictionary entries returned by a provider.
+type DictionaryResult struct {
+	ID      string            `json:"id"`
+	Results []DictionaryEntry `json:"results"`
+	Service ServiceInfo       `json:"service"`
+}
+
+// DictionaryEntry describes the meaning of the word as a part of speech.
+type DictionaryEntry struct {
+	// PartOfSpeech is e.g. noun, verb or adjective.
+	PartOfSpeech string                  `json:"pos"`
+	Translations []DictionaryTranslation `json:"translations"`
+}
+
+// DictionaryTranslation describes a translation of the word.
+type DictionaryTranslation struct {
+	Text string `json:"text"`
+	// BackTranslations are translations of Text back to the source language.
+	BackTranslations []string            `json:"back_translations"`
+	Examples         []DictionaryExample `json:"examples"`
+}
+
+// DictionaryExample is a usage example of the translation.
+type DictionaryExample struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// DictionaryFailure describes a provider of the lookup that has failed.
+type DictionaryFailure struct {
+	ProviderID string
+	Err        error
+}
+
+// DictionaryError is returned when some providers of the lookup have failed.
+type DictionaryError struct {
+	Failures []DictionaryFailure
+}
+
+func (e *DictionaryError) Error() string {
+	messages := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		messages = append(messages, "provider "+failure.ProviderID+": "+failure.Err.Error())
+	}
+
+	return "intento: dictionary lookup failed: " + strings.Join(messages, "; ")
+}
+
+func (e *DictionaryError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure.Err)
+	}
+
+	return errs
+}
+
+// As finds the first error of the failures that matches target.
+func (e *DictionaryError) As(target interface{}) bool {
+	return asAny(e.Unwrap(), target)
+}
+
+// Is reports whether any error of the failures matches target.
+func (e *DictionaryError) Is(target error) bool {
+	return isAny(e.Unwrap(), target)
+}
+
+// DictionaryLookup looks the word up in the dictionary.
+//
+// One result is returned per provider set with DictionaryWithProvider, the
+// providers are requested concurrently. Without providers, the dictionary is
+// selected by Intento and a single result is returned. If some providers
+// fail, the results of the others are returned along with a
+// *DictionaryError listing every failed provider.
+func (c *Client) DictionaryLookup(
+	ctx context.Context,
+	word string,
+	from string,
+	to string,
+	options ...DictionaryOption,
+) ([]DictionaryResult, error) {
+	params := dictionaryOptions{}
+	params.Context.Text = word
+	params.Context.From = from
+	params.Context.To = to
+
+	for _, opt := range options {
+		opt.applyDictionary(&params)
+	}
+
+	providers := params.providers
+	if len(providers) == 0 {
+		providers = []string{params.Service.Provider}
+	}
+
+	results := make([]DictionaryResult, len(providers))
+	errs := make([]error, len(providers))
+	wg := sync.WaitGroup{}
+
+	for i, provider := range providers {
+		wg.Add(1)
+		go func(i int, provider string) {
+			defer wg.Done()
+
+			providerParams := params
+			providerParams.Service.Provider = provider
+
+			errs[i] = c.intentRequest(ctx, c.url(endpointDictionary), []string{word}, false, &providerParams, &results[i])
+		}(i, provider)
+	}
+
+	wg.Wait()
+
+	if len(params.providers) == 0 {
+		if errs[0] != nil {
+			return nil, errs[0]
+		}
+
+		return results, nil
+	}
+
+	var (
+		succeeded       []DictionaryResult
+		dictionaryError DictionaryError
+	)
+
+	for i, err := range errs {
+		if err != nil {
+			dictionaryError.Failures = append(dictionaryError.Failures, DictionaryFailure{ProviderID: providers[i], Err: err})
+			continue
+		}
+
+		succeeded = append(succeeded, results[i])
+	}
+
+	if len(dictionaryError.Failures) > 0 {
+		return succeeded, &dictionaryError
+	}
+
+	return succeeded, nil
+}
+
+// DictionaryWithProvider sets the dictionary providers to compare.
+func DictionaryWithProvider(providerIDs ...string) DictionaryOption {
+	return newFuncDictionaryOption(func(o *dictionaryOptions) {
+		o.providers = providerIDs
+	})
+}
+
+// DictionaryWithSmartRouting sets a smart routing, see TranslationWithSmartRouting.
+func DictionaryWithSmartRouting(routing string) DictionaryOption {
+	return newFuncDictionaryOption(func(o *dictionaryOptions) {
+		o.Service.Routing = routing
+	})
+}
+
+// DictionaryWithTrace sets trace flag, see TranslationWithTrace.
+func DictionaryWithTrace() DictionaryOption {
+	return newFuncDictionaryOption(func(o *dictionaryOptions) {
+		o.Service.Trace = true
+	})
+}
+
+// DictionaryOption configures how we look a word up.
+type DictionaryOption interface {
+	applyDictionary(*dictionaryOptions)
+}
+
+// dictionaryOptions configure a dictionary lookup.
+type dictionaryOptions struct {
+	Context struct {
+		Text string `json:"text,omitempty"`
+		From string `json:"from,omitempty"`
+		To   string `json:"to,omitempty"`
+	} `json:"context"`
+	Service serviceOptions `json:"service"`
+
+	// providers are requested one by one.
+	providers []string
+}
+
+// funcDictionaryOption wraps a function that modifies dictionaryOptions into an implementation of the DictionaryOption interface.
+type funcDictionaryOption struct {
+	fn func(*dictionaryOptions)
+}
+
+func (fdo *funcDictionaryOption) applyDictionary(do *dictionaryOptions) {
+	fdo.fn(do)
+}
+
+func newFuncDictionaryOption(fn func(*dictionaryOptions)) *funcDictionaryOption {
+	return &funcDictionaryOption{
+		fn: fn,
+	}
+}
@@ -0,0 +1,68 @@
+package intento_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_DictionaryLookup(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			if strings.Contains(string(body), `"provider":"p2"`) || strings.Contains(string(body), `"provider":"p3"`) {
+				return &http.Response{
+					StatusCode: 404,
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Body: io.NopCloser(strings.NewReader(`{"results":[{"pos":"noun","translations":[` +
+					`{"text":"casa","back_translations":["house","home"],"examples":[{"source":"my house","target":"mi casa"}]}` +
+					`]}],"service":{"provider":{"id":"p1"}}}`)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	results, err := client.DictionaryLookup(ctx, "house", "en", "es", intento.DictionaryWithProvider("p1", "p2", "p3"))
+
+	var dictionaryError *intento.DictionaryError
+	if assert.ErrorAs(t, err, &dictionaryError) && assert.Len(t, dictionaryError.Failures, 2) {
+		assert.Equal(t, "p2", dictionaryError.Failures[0].ProviderID)
+		assert.Equal(t, "p3", dictionaryError.Failures[1].ProviderID)
+	}
+
+	var notFoundError *intento.NotFoundError
+	assert.ErrorAs(t, err, &notFoundError)
+	assert.True(t, dictionaryError.As(&notFoundError))
+	assert.Contains(t, err.Error(), "provider p2")
+	assert.Contains(t, err.Error(), "provider p3")
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, "p1", results[0].Service.Provider.ID)
+	assert.Equal(t, []intento.DictionaryEntry{{
+		PartOfSpeech: "noun",
+		Translations: []intento.DictionaryTranslation{{
+			Text:             "casa",
+			BackTranslations: []string{"house", "home"},
+			Examples:         []intento.DictionaryExample{{Source: "my house", Target: "mi casa"}},
+		}},
+	}}, results[0].Results)
+	assert.Len(t, mockHttpClient.DoCalls(), 3)
+}
@@ -0,0 +1,75 @@
+package intento
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// DefaultBaseURL is the base URL of the Intento API.
+	DefaultBaseURL = "https://api.inten.to"
+	// DefaultSyncWrapperURL is the base URL of the Intento synchronous wrapper
+	// that waits for the result of an intent instead of returning an operation.
+	DefaultSyncWrapperURL = "https://syncwrapper.inten.to"
+)
+
+// host selects the base URL an endpoint is served from.
+type host int
+
+const (
+	hostAPI host = iota
+	hostSyncWrapper
+)
+
+// endpoint describes an Intento API endpoint relative to its base URL.
+//
+// The path may contain fmt verbs that are filled with path-escaped arguments.
+type endpoint struct {
+	host host
+	path string
+}
+
+var (
+	endpointProviders              = endpoint{hostSyncWrapper, "/ai/text/translate"}
+	endpointProvider               = endpoint{hostSyncWrapper, "/ai/text/translate/%s"}
+	endpointCustomModels           = endpoint{hostAPI, "/ai/text/translate/models"}
+	endpointLanguages              = endpoint{hostSyncWrapper, "/ai/text/translate/languages"}
+	endpointRouting                = endpoint{hostAPI, "/ai/text/translate/routing"}
+	endpointTranslate              = endpoint{hostSyncWrapper, "/ai/text/translate"}
+	endpointTranslateAsync         = endpoint{hostAPI, "/ai/text/translate"}
+	endpointOperation              = endpoint{hostAPI, "/operations/%s"}
+	endpointGlossaries             = endpoint{hostAPI, "/terminology/glossaries"}
+	endpointGlossary               = endpoint{hostAPI, "/terminology/glossaries/%s"}
+	endpointGlossaryUpload         = endpoint{hostAPI, "/terminology/glossaries/%s/entries"}
+	endpointCredentials            = endpoint{hostAPI, "/auth/delegated"}
+	endpointCredential             = endpoint{hostAPI, "/auth/delegated/%s"}
+	endpointCredentialStatus       = endpoint{hostAPI, "/auth/delegated/%s/status"}
+	endpointUsage                  = endpoint{hostAPI, "/usage/%s"}
+	endpointDetectLanguage         = endpoint{hostSyncWrapper, "/ai/text/detect-language"}
+	endpointSentiment              = endpoint{hostSyncWrapper, "/ai/text/sentiment"}
+	endpointSentimentAsync         = endpoint{hostAPI, "/ai/text/sentiment"}
+	endpointTransliterate          = endpoint{hostSyncWrapper, "/ai/text/transliterate"}
+	endpointTransliterationScripts = endpoint{hostSyncWrapper, "/ai/text/transliterate/scripts"}
+	endpointDictionary             = endpoint{hostSyncWrapper, "/ai/text/dictionary"}
+)
+
+// url builds the absolute URL of the endpoint from the client configuration.
+func (c *Client) url(e endpoint, args ...string) string {
+	base := c.baseURL
+	if e.host == hostSyncWrapper {
+		base = c.syncWrapperURL
+	}
+
+	path := e.path
+	if len(args) > 0 {
+		escaped := make([]interface{}, len(args))
+		for i, arg := range args {
+			escaped[i] = url.PathEscape(arg)
+		}
+
+		path = fmt.Sprintf(path, escaped...)
+	}
+
+	return strings.TrimRight(base, "/") + path
+}
@@ -1,86 +1,359 @@
 package intento
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
 
-type ProviderRelatedError struct{}
+// maxErrorBodySize limits how much of an error response body is kept.
+const maxErrorBodySize = 1 << 20
+
+// APIError describes an unsuccessful response of the Intento API.
+//
+// The typed errors below wrap APIError, so both the typed error and APIError
+// can be extracted with errors.As.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// RequestID is the value of the X-Request-Id response header.
+	RequestID string
+	// Body is the raw response body.
+	Body []byte
+	// Code, Message and Details are parsed from the Intento error JSON.
+	Code    int
+	Message string
+	Details json.RawMessage
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("intento: %s %s: status code %d", e.Method, e.URL, e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+
+	if e.RequestID != "" {
+		msg += " (request id " + e.RequestID + ")"
+	}
+
+	return msg
+}
+
+// describe appends the message from the response to the summary of a typed error.
+func (e *APIError) describe(summary string) string {
+	if e == nil || e.Message == "" {
+		return summary
+	}
+
+	return summary + ": " + e.Message
+}
+
+// unwrap returns e as an error, keeping a nil APIError a nil error.
+func (e *APIError) unwrap() error {
+	if e == nil {
+		return nil
+	}
+
+	return e
+}
+
+type ProviderRelatedError struct {
+	APIError *APIError
+}
 
 func (e *ProviderRelatedError) Error() string {
-	return "provider-related error"
+	return e.APIError.describe("provider-related error")
 }
 
-type AuthKeyIsMissingError struct{}
+func (e *ProviderRelatedError) Unwrap() error {
+	return e.APIError.unwrap()
+}
+
+type AuthKeyIsMissingError struct {
+	APIError *APIError
+}
 
 func (e *AuthKeyIsMissingError) Error() string {
-	return "intento: auth key is missing"
+	return e.APIError.describe("intento: auth key is missing")
 }
 
-type AuthKeyIsInvalidError struct{}
+func (e *AuthKeyIsMissingError) Unwrap() error {
+	return e.APIError.unwrap()
+}
+
+type AuthKeyIsInvalidError struct {
+	APIError *APIError
+}
 
 func (e *AuthKeyIsInvalidError) Error() string {
-	return "intento: auth key is invalid"
+	return e.APIError.describe("intento: auth key is invalid")
 }
 
-type NotFoundError struct{}
+func (e *AuthKeyIsInvalidError) Unwrap() error {
+	return e.APIError.unwrap()
+}
+
+type NotFoundError struct {
+	APIError *APIError
+}
 
 func (e *NotFoundError) Error() string {
-	return "intento: intent/provider not found"
+	return e.APIError.describe("intento: intent/provider not found")
 }
 
-type CapabilitiesMismatchError struct{}
+func (e *NotFoundError) Unwrap() error {
+	return e.APIError.unwrap()
+}
+
+type CapabilitiesMismatchError struct {
+	APIError *APIError
+}
 
 func (e *CapabilitiesMismatchError) Error() string {
-	return "intento: capabilities mismatch for the chosen provider"
+	return e.APIError.describe("intento: capabilities mismatch for the chosen provider")
 }
 
-type APIRateLimitError struct{}
+func (e *CapabilitiesMismatchError) Unwrap() error {
+	return e.APIError.unwrap()
+}
+
+type APIRateLimitError struct {
+	APIError *APIError
+	// RetryAfter is the delay requested by the Retry-After header, zero if absent.
+	RetryAfter time.Duration
+}
 
 func (e *APIRateLimitError) Error() string {
-	return "intento: API rate limit exceeded"
+	return e.APIError.describe("intento: API rate limit exceeded")
+}
+
+func (e *APIRateLimitError) Unwrap() error {
+	return e.APIError.unwrap()
 }
 
-type InternalError struct{}
+type InternalError struct {
+	APIError *APIError
+}
 
 func (e *InternalError) Error() string {
-	return "intento: internal error"
+	return e.APIError.describe("intento: internal error")
 }
 
-type NotImplemented struct{}
+func (e *InternalError) Unwrap() error {
+	return e.APIError.unwrap()
+}
+
+type NotImplemented struct {
+	APIError *APIError
+}
 
 func (e *NotImplemented) Error() string {
-	return "intento: not implemented"
+	return e.APIError.describe("intento: not implemented")
+}
+
+func (e *NotImplemented) Unwrap() error {
+	return e.APIError.unwrap()
 }
 
-type GatewayTimeoutError struct{}
+type GatewayTimeoutError struct {
+	APIError *APIError
+}
 
 func (e *GatewayTimeoutError) Error() string {
-	return "intento: gateway timeout errors"
+	return e.APIError.describe("intento: gateway timeout errors")
+}
+
+func (e *GatewayTimeoutError) Unwrap() error {
+	return e.APIError.unwrap()
+}
+
+// CustomModelNotSupportedError is returned before sending a translation with
+// a custom model when the provider is not set or does not support custom models.
+type CustomModelNotSupportedError struct {
+	ProviderID string
 }
 
-func httpStatusCodeToError(statusCode int) error {
-	if statusCode >= 200 && statusCode <= 299 {
+func (e *CustomModelNotSupportedError) Error() string {
+	if e.ProviderID == "" {
+		return "intento: custom model requires a provider"
+	}
+
+	return "intento: provider " + e.ProviderID + " does not support custom models"
+}
+
+// LanguageNotSupportedError is returned before sending a translation with
+// TranslationWithLanguageTags when a tag matches no available language.
+type LanguageNotSupportedError struct {
+	Tag string
+}
+
+func (e *LanguageNotSupportedError) Error() string {
+	return fmt.Sprintf("intento: language %q is not supported", e.Tag)
+}
+
+// newUnknownProviderError returns the *NotFoundError the API responds with to
+// an unknown provider, for the checks made before a request is sent.
+func newUnknownProviderError(providerID string) *NotFoundError {
+	return &NotFoundError{APIError: &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("unknown provider %q", providerID),
+	}}
+}
+
+// TransportError is returned when the HTTP request could not be sent or its
+// response could not be received.
+type TransportError struct {
+	Err error
+	// Idempotent reports whether the request can be sent again without side
+	// effects. Requests creating an operation or a resource are not, since
+	// the failed attempt may have reached the server.
+	Idempotent bool
+}
+
+func (e *TransportError) Error() string {
+	return "intento: transport error: " + e.Err.Error()
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// httpResponseToError converts an unsuccessful response into an error, the
+// response body is consumed.
+func httpResponseToError(req *http.Request, resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
 		return nil
 	}
 
-	switch statusCode {
+	apiError := newAPIError(req, resp)
+
+	switch resp.StatusCode {
 	case 400:
-		return &ProviderRelatedError{}
+		return &ProviderRelatedError{APIError: apiError}
 	case 401:
-		return &AuthKeyIsMissingError{}
+		return &AuthKeyIsMissingError{APIError: apiError}
 	case 403:
-		return &AuthKeyIsInvalidError{}
+		return &AuthKeyIsInvalidError{APIError: apiError}
 	case 404:
-		return &NotFoundError{}
+		return &NotFoundError{APIError: apiError}
 	case 413:
-		return &CapabilitiesMismatchError{}
+		return &CapabilitiesMismatchError{APIError: apiError}
 	case 429:
-		return &APIRateLimitError{}
+		return &APIRateLimitError{
+			APIError:   apiError,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
 	case 500:
-		return &InternalError{}
+		return &InternalError{APIError: apiError}
 	case 501:
-		return &NotImplemented{}
+		return &NotImplemented{APIError: apiError}
 	case 502:
-		return &GatewayTimeoutError{}
+		return &GatewayTimeoutError{APIError: apiError}
 	default:
-		return errors.New("unexpected status code")
+		return apiError
+	}
+}
+
+func newAPIError(req *http.Request, resp *http.Response) *APIError {
+	apiError := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil || len(body) == 0 {
+		return apiError
 	}
+
+	apiError.Body = body
+
+	var response struct {
+		Error json.RawMessage `json:"error"`
+	}
+
+	err = json.Unmarshal(body, &response)
+	if err != nil || len(response.Error) == 0 {
+		return apiError
+	}
+
+	var details struct {
+		Code    int             `json:"code"`
+		Message string          `json:"message"`
+		Data    json.RawMessage `json:"data"`
+	}
+
+	err = json.Unmarshal(response.Error, &details)
+	if err != nil {
+		// Some errors are reported as a plain string.
+		_ = json.Unmarshal(response.Error, &apiError.Message)
+		return apiError
+	}
+
+	apiError.Code = details.Code
+	apiError.Message = details.Message
+	apiError.Details = details.Data
+
+	return apiError
+}
+
+// parseRetryAfter parses the value of the Retry-After header, which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds < 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0
+	}
+
+	delay := time.Until(date)
+	if delay < 0 {
+		return 0
+	}
+
+	return delay
+}
+
+// asAny finds the first error of errs that matches target.
+//
+// The errors wrapping several ones implement As and Is with asAny and isAny
+// next to Unwrap() []error, since errors.As and errors.Is only follow the
+// latter from Go 1.20 on.
+func asAny(errs []error, target interface{}) bool {
+	for _, err := range errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAny reports whether any error of errs matches target, see asAny.
+func isAny(errs []error, target error) bool {
+	for _, err := range errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
 }
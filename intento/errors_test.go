@@ -0,0 +1,67 @@
+package intento_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_apiError(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 400,
+				Header:     http.Header{"X-Request-Id": []string{"req-1"}},
+				Body: io.NopCloser(strings.NewReader(
+					`{"error":{"code":400,"message":"unsupported language","data":[{"provider":"p1"}]}}`,
+				)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	_, err := client.Translate(ctx, text, "en", "xx")
+
+	var providerRelatedError *intento.ProviderRelatedError
+	assert.ErrorAs(t, err, &providerRelatedError)
+
+	var apiError *intento.APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 400, apiError.StatusCode)
+	assert.Equal(t, http.MethodPost, apiError.Method)
+	assert.Equal(t, "req-1", apiError.RequestID)
+	assert.Equal(t, "unsupported language", apiError.Message)
+	assert.JSONEq(t, `[{"provider":"p1"}]`, string(apiError.Details))
+	assert.Contains(t, err.Error(), "provider-related error: unsupported language")
+}
+
+func TestClient_apiError_unexpectedStatusCode(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 418,
+				Body:       io.NopCloser(strings.NewReader("teapot")),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	_, err := client.AvailableLanguages(ctx)
+
+	var apiError *intento.APIError
+	assert.ErrorAs(t, err, &apiError)
+	assert.Equal(t, 418, apiError.StatusCode)
+	assert.Equal(t, "teapot", string(apiError.Body))
+}
@@ -0,0 +1,106 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// Glossary describes a glossary stored on the Intento side.
+type Glossary struct {
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	Pairs        []LanguagePair `json:"pairs"`
+	EntriesCount int            `json:"entries_count"`
+}
+
+// GlossaryParams describes the glossary to create.
+type GlossaryParams struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Pairs       []LanguagePair `json:"pairs,omitempty"`
+}
+
+// GlossaryFormat is the format of uploaded glossary entries.
+type GlossaryFormat string
+
+const (
+	GlossaryFormatCSV GlossaryFormat = "csv"
+	GlossaryFormatTSV GlossaryFormat = "tsv"
+	GlossaryFormatTBX GlossaryFormat = "tbx"
+)
+
+// Glossaries returns the list of glossaries.
+func (c *Client) Glossaries(ctx context.Context) ([]Glossary, error) {
+	var response struct {
+		Glossaries []Glossary `json:"glossaries"`
+	}
+
+	err := c.apiGetRequest(ctx, c.url(endpointGlossaries), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Glossaries, nil
+}
+
+// CreateGlossary creates an empty glossary, use UpdateGlossary to fill it.
+func (c *Client) CreateGlossary(ctx context.Context, params GlossaryParams) (Glossary, error) {
+	var glossary Glossary
+
+	err := c.apiCreateRequest(ctx, c.url(endpointGlossaries), &params, &glossary)
+	if err != nil {
+		return Glossary{}, err
+	}
+
+	return glossary, nil
+}
+
+// Glossary returns the glossary with given ID.
+func (c *Client) Glossary(ctx context.Context, id string) (Glossary, error) {
+	var glossary Glossary
+
+	err := c.apiGetRequest(ctx, c.url(endpointGlossary, id), &glossary)
+	if err != nil {
+		return Glossary{}, err
+	}
+
+	return glossary, nil
+}
+
+// UpdateGlossary uploads entries of the glossary in given format, the
+// uploaded entries replace the existing ones.
+func (c *Client) UpdateGlossary(
+	ctx context.Context,
+	id string,
+	format GlossaryFormat,
+	entries io.Reader,
+) (Glossary, error) {
+	content, err := io.ReadAll(entries)
+	if err != nil {
+		return Glossary{}, fmt.Errorf("read glossary entries: %w", err)
+	}
+
+	params := struct {
+		Format  GlossaryFormat `json:"format"`
+		Content string         `json:"content"`
+	}{
+		Format:  format,
+		Content: string(content),
+	}
+
+	var glossary Glossary
+
+	err = c.apiPostRequest(ctx, c.url(endpointGlossaryUpload, id), &params, &glossary)
+	if err != nil {
+		return Glossary{}, err
+	}
+
+	return glossary, nil
+}
+
+// DeleteGlossary deletes the glossary with given ID.
+func (c *Client) DeleteGlossary(ctx context.Context, id string) error {
+	return c.apiDeleteRequest(ctx, c.url(endpointGlossary, id), nil)
+}
@@ -0,0 +1,68 @@
+package intento_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_UpdateGlossary(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body: io.NopCloser(strings.NewReader(
+					`{"id":"g-1","name":"terms","pairs":[{"from":"en","to":"es"}],"entries_count":2}`,
+				)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	glossary, err := client.UpdateGlossary(ctx, "g-1", intento.GlossaryFormatCSV, strings.NewReader("en,es\nhello,hola\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, glossary.EntriesCount)
+	assert.Equal(t, []intento.LanguagePair{{From: "en", To: "es"}}, glossary.Pairs)
+
+	req := mockHttpClient.DoCalls()[0].Req
+	assert.Equal(t, "/terminology/glossaries/g-1/entries", req.URL.Path)
+
+	var body struct {
+		Format  string `json:"format"`
+		Content string `json:"content"`
+	}
+	assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+	assert.Equal(t, "csv", body.Format)
+	assert.Equal(t, "en,es\nhello,hola\n", body.Content)
+}
+
+func TestClient_DeleteGlossary(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 204,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	err := client.DeleteGlossary(ctx, "g-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodDelete, mockHttpClient.DoCalls()[0].Req.Method)
+}
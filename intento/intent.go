@@ -0,0 +1,92 @@
+package intento
+
+import (
+	"context"
+	"strings"
+)
+
+// Do sends a request to an intent the SDK does not wrap yet.
+//
+// The intent is the path of the intent, e.g. "ai/text/classify". The payload
+// is sent as the context section of the request and the response is
+// unmarshaled into result. The request shares authentication, error mapping,
+// retries, rate limiting and logging with the other methods of the Client.
+//
+// With ServiceWithAsync, the request is sent to the asynchronous API and
+// result receives the operation, see Client.Intent for a builder that
+// returns an Operation.
+func (c *Client) Do(
+	ctx context.Context,
+	intent string,
+	payload interface{},
+	result interface{},
+	options ...IntentOption,
+) error {
+	params := intentOptions{Context: payload}
+
+	for _, opt := range options {
+		opt.applyService(&params.Service)
+	}
+
+	e := endpoint{hostSyncWrapper, "/" + strings.Trim(intent, "/")}
+	if params.Service.Async {
+		e.host = hostAPI
+	}
+
+	return c.intentRequest(ctx, c.url(e), nil, params.Service.Async, &params, result)
+}
+
+// IntentRequest builds a request to an intent, see Client.Intent.
+type IntentRequest struct {
+	client  *Client
+	intent  string
+	payload interface{}
+	options []IntentOption
+}
+
+// Intent starts building a request to the intent with given path, e.g.
+// "ai/text/classify".
+func (c *Client) Intent(intent string) *IntentRequest {
+	return &IntentRequest{
+		client: c,
+		intent: intent,
+	}
+}
+
+// Payload sets the context section of the request.
+func (r *IntentRequest) Payload(payload interface{}) *IntentRequest {
+	r.payload = payload
+	return r
+}
+
+// Options adds service options to the request.
+func (r *IntentRequest) Options(options ...IntentOption) *IntentRequest {
+	r.options = append(r.options, options...)
+	return r
+}
+
+// Do sends the request and unmarshals the response into result.
+func (r *IntentRequest) Do(ctx context.Context, result interface{}) error {
+	return r.client.Do(ctx, r.intent, r.payload, result, r.options...)
+}
+
+// Async sends the request in the asynchronous mode, use Client.Wait and
+// Operation.DecodeResponse to get the result.
+func (r *IntentRequest) Async(ctx context.Context) (Operation, error) {
+	var operation Operation
+
+	options := append(append([]IntentOption(nil), r.options...), ServiceWithAsync())
+
+	err := r.client.Do(ctx, r.intent, r.payload, &operation, options...)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	return operation, nil
+}
+
+// intentOptions configure a request to an arbitrary intent.
+type intentOptions struct {
+	Context interface{}    `json:"context"`
+	Service serviceOptions `json:"service"`
+}
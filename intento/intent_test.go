@@ -0,0 +1,97 @@
+package intento_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestClient_Do(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.Respond(http.MethodPost, "/ai/text/classify", intentotest.Response{Body: `{"results":["sports"]}`})
+
+	client := server.Client()
+
+	var result struct {
+		Results []string `json:"results"`
+	}
+
+	err := client.Do(
+		ctx,
+		"/ai/text/classify",
+		map[string]interface{}{"text": "Goal!"},
+		&result,
+		intento.ServiceWithProvider("p1"),
+		intento.ServiceWithTrace(),
+		intento.ServiceWithCredentials("p1", intento.StoredCredential("c1")),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sports"}, result.Results)
+
+	assert.JSONEq(
+		t,
+		`{"context":{"text":"Goal!"},"service":{"provider":"p1","trace":true,"auth":{"p1":[{"credential_id":"c1"}]}}}`,
+		string(server.Requests()[0].Body),
+	)
+}
+
+func TestClient_Intent(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.Respond(http.MethodPost, "/ai/text/classify", intentotest.Response{Body: `{"id":"op-1"}`})
+	server.Respond(http.MethodPost, "/ai/text/classify", intentotest.ErrorResponse(http.StatusBadRequest, "bad payload"))
+
+	client := server.Client()
+
+	operation, err := client.Intent("ai/text/classify").
+		Payload(map[string]interface{}{"text": "Goal!"}).
+		Options(intento.ServiceWithSmartRouting("best")).
+		Async(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "op-1", operation.ID)
+
+	assert.JSONEq(
+		t,
+		`{"context":{"text":"Goal!"},"service":{"routing":"best","async":true}}`,
+		string(server.Requests()[0].Body),
+	)
+
+	err = client.Intent("ai/text/classify").Do(ctx, nil)
+	assert.ErrorAs(t, err, new(*intento.ProviderRelatedError))
+}
+
+func TestServiceOption(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	_, err := client.Translate(ctx, []string{"hello"}, "en", "de", intento.ServiceWithProvider("p1"))
+	assert.NoError(t, err)
+
+	var body struct {
+		Service struct {
+			Provider string `json:"provider"`
+		} `json:"service"`
+	}
+
+	assert.NoError(t, server.Requests()[0].Decode(&body))
+	assert.Equal(t, "p1", body.Service.Provider)
+
+	_, ok := intento.ServiceWithAsync().(intento.TranslationOption)
+	assert.False(t, ok, "the synchronous methods do not accept the asynchronous mode")
+}
@@ -0,0 +1,478 @@
+// Package intentotest provides a local stand-in for the Intento API to test
+// code that uses the intento package without network access.
+package intentotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"intento-golang/intento"
+)
+
+// APIKey is the API key accepted by the Server.
+const APIKey = "intentotest"
+
+// Request describes a request received by the Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+// Decode unmarshals the JSON body of the request into v.
+func (r Request) Decode(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Response describes a scripted response of the Server.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	// Body is written as is if it is a string or []byte, and marshaled to
+	// JSON otherwise.
+	Body interface{}
+}
+
+// ErrorResponse returns a response with given status code and the Intento
+// error JSON.
+func ErrorResponse(statusCode int, message string) Response {
+	body := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    statusCode,
+			"message": message,
+		},
+	}
+
+	return Response{StatusCode: statusCode, Body: body}
+}
+
+// TranslateFunc translates text in the emulated translate intent.
+type TranslateFunc func(text []string, from, to string) []string
+
+// Server is a local stand-in for the Intento API serving both the API and the
+// synchronous wrapper endpoints.
+//
+// By default, the Server translates text by prefixing it with the target
+// language, e.g. "[es] Hello", and finishes asynchronous operations on the
+// first poll. Any endpoint can be scripted with Respond.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	requests     []Request
+	scripts      map[string][]Response
+	providers    []intento.Provider
+	languages    []intento.Language
+	routing      []intento.SmartRouting
+	customModels map[string][]intento.CustomModel
+	translate    TranslateFunc
+	operations   map[string]*operation
+	pendingPolls int
+	glossaries   map[string]intento.Glossary
+	glossaryIDs  []string
+	nextID       int
+}
+
+// operation is an asynchronous operation emulated by the Server.
+type operation struct {
+	polls    int
+	response interface{}
+}
+
+// NewServer starts and returns a new Server, the caller should call Close
+// when finished.
+func NewServer() *Server {
+	s := &Server{
+		scripts:      make(map[string][]Response),
+		translate:    prefixTranslate,
+		operations:   make(map[string]*operation),
+		customModels: make(map[string][]intento.CustomModel),
+		glossaries:   make(map[string]intento.Glossary),
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns an intento.Client configured to send requests to the Server.
+func (s *Server) Client(options ...intento.ClientOption) *intento.Client {
+	options = append([]intento.ClientOption{
+		intento.ClientWithHttpClient(s.Server.Client()),
+		intento.ClientWithBaseURL(s.URL),
+		intento.ClientWithSyncWrapperURL(s.URL),
+	}, options...)
+
+	return intento.New(APIKey, options...)
+}
+
+// SetProviders sets the providers returned by the Server.
+func (s *Server) SetProviders(providers []intento.Provider) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.providers = providers
+}
+
+// SetLanguages sets the languages returned by the Server.
+func (s *Server) SetLanguages(languages []intento.Language) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.languages = languages
+}
+
+// SetRouting sets the smart routing list returned by the Server.
+func (s *Server) SetRouting(routing []intento.SmartRouting) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routing = routing
+}
+
+// SetCustomModels sets the custom models of the provider returned by the Server.
+func (s *Server) SetCustomModels(providerID string, models []intento.CustomModel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.customModels[providerID] = models
+}
+
+// SetTranslateFunc sets the function translating text.
+func (s *Server) SetTranslateFunc(fn TranslateFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.translate = fn
+}
+
+// SetPendingPolls sets how many polls an asynchronous operation stays
+// pending before it is done.
+func (s *Server) SetPendingPolls(polls int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pendingPolls = polls
+}
+
+// Respond scripts the responses to requests with given method and path, the
+// responses are used in order and the endpoint falls back to its default
+// behavior once they run out.
+func (s *Server) Respond(method, path string, responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := method + " " + path
+	s.scripts[key] = append(s.scripts[key], responses...)
+}
+
+// Requests returns the requests received by the Server.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset forgets received requests and scripted responses.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = nil
+	s.scripts = make(map[string][]Response)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeResponse(w, ErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	request := Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, request)
+
+	key := r.Method + " " + r.URL.Path
+	if responses := s.scripts[key]; len(responses) > 0 {
+		s.scripts[key] = responses[1:]
+		writeResponse(w, responses[0])
+		return
+	}
+
+	if r.Header.Get("apikey") == "" {
+		writeResponse(w, ErrorResponse(http.StatusUnauthorized, "auth key is missing"))
+		return
+	}
+
+	writeResponse(w, s.handle(request))
+}
+
+// handle emulates the endpoint, it is called with the mutex locked.
+func (s *Server) handle(r Request) Response {
+	segments := strings.Split(strings.Trim(r.Path, "/"), "/")
+
+	switch {
+	case r.Path == "/ai/text/translate" && r.Method == http.MethodGet:
+		return jsonResponse(s.providers)
+	case r.Path == "/ai/text/translate" && r.Method == http.MethodPost:
+		return s.handleTranslate(r)
+	case r.Path == "/ai/text/translate/languages" && r.Method == http.MethodGet:
+		return jsonResponse(s.languages)
+	case r.Path == "/ai/text/translate/routing" && r.Method == http.MethodGet:
+		return jsonResponse(map[string]interface{}{"routing": s.routing})
+	case r.Path == "/ai/text/translate/models" && r.Method == http.MethodGet:
+		return s.handleCustomModels(r)
+	case len(segments) == 4 && strings.HasPrefix(r.Path, "/ai/text/translate/") && r.Method == http.MethodGet:
+		return s.handleProvider(segments[3])
+	case len(segments) == 2 && segments[0] == "operations" && r.Method == http.MethodGet:
+		return s.handleOperation(segments[1])
+	case r.Path == "/terminology/glossaries":
+		return s.handleGlossaries(r)
+	case len(segments) >= 3 && strings.HasPrefix(r.Path, "/terminology/glossaries/"):
+		return s.handleGlossary(r, segments[2:])
+	default:
+		return ErrorResponse(http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) handleTranslate(r Request) Response {
+	var params struct {
+		Context struct {
+			Text []string `json:"text"`
+			From string   `json:"from"`
+			To   string   `json:"to"`
+		} `json:"context"`
+		Service struct {
+			Async    bool   `json:"async"`
+			Provider string `json:"provider"`
+		} `json:"service"`
+	}
+
+	err := r.Decode(&params)
+	if err != nil {
+		return ErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	var result intento.TranslationResult
+	result.Results = s.translate(params.Context.Text, params.Context.From, params.Context.To)
+	result.Service.Provider.ID = params.Service.Provider
+
+	if params.Context.From == intento.AutoDetectSourceLanguage {
+		for range params.Context.Text {
+			result.Meta.DetectedSourceLanguage = append(result.Meta.DetectedSourceLanguage, "en")
+		}
+	}
+
+	if params.Service.Async {
+		id := s.newID("operation")
+		s.operations[id] = &operation{response: []intento.TranslationResult{result}}
+
+		return jsonResponse(map[string]string{"id": id})
+	}
+
+	result.ID = s.newID("translation")
+
+	return jsonResponse(result)
+}
+
+func (s *Server) handleProvider(id string) Response {
+	for _, provider := range s.providers {
+		if provider.ID == id {
+			return jsonResponse(provider)
+		}
+	}
+
+	return ErrorResponse(http.StatusNotFound, "provider not found")
+}
+
+func (s *Server) handleCustomModels(r Request) Response {
+	query, err := url.ParseQuery(r.Query)
+	if err != nil {
+		return ErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	models := s.customModels[query.Get("provider")]
+	if models == nil {
+		models = []intento.CustomModel{}
+	}
+
+	return jsonResponse(map[string]interface{}{"data": models})
+}
+
+func (s *Server) handleOperation(id string) Response {
+	op, ok := s.operations[id]
+	if !ok {
+		return ErrorResponse(http.StatusNotFound, "operation not found")
+	}
+
+	op.polls++
+	if op.polls <= s.pendingPolls {
+		return jsonResponse(map[string]interface{}{"id": id, "done": false})
+	}
+
+	return jsonResponse(map[string]interface{}{"id": id, "done": true, "response": op.response})
+}
+
+func (s *Server) handleGlossaries(r Request) Response {
+	switch r.Method {
+	case http.MethodGet:
+		glossaries := make([]intento.Glossary, 0, len(s.glossaryIDs))
+		for _, id := range s.glossaryIDs {
+			glossaries = append(glossaries, s.glossaries[id])
+		}
+
+		return jsonResponse(map[string]interface{}{"glossaries": glossaries})
+	case http.MethodPost:
+		var params intento.GlossaryParams
+
+		err := r.Decode(&params)
+		if err != nil {
+			return ErrorResponse(http.StatusBadRequest, err.Error())
+		}
+
+		glossary := intento.Glossary{
+			ID:          s.newID("glossary"),
+			Name:        params.Name,
+			Description: params.Description,
+			Pairs:       params.Pairs,
+		}
+
+		s.glossaries[glossary.ID] = glossary
+		s.glossaryIDs = append(s.glossaryIDs, glossary.ID)
+
+		return jsonResponse(glossary)
+	default:
+		return ErrorResponse(http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) handleGlossary(r Request, segments []string) Response {
+	id := segments[0]
+
+	glossary, ok := s.glossaries[id]
+	if !ok {
+		return ErrorResponse(http.StatusNotFound, "glossary not found")
+	}
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		return jsonResponse(glossary)
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		delete(s.glossaries, id)
+
+		for i, glossaryID := range s.glossaryIDs {
+			if glossaryID == id {
+				s.glossaryIDs = append(s.glossaryIDs[:i], s.glossaryIDs[i+1:]...)
+				break
+			}
+		}
+
+		return Response{StatusCode: http.StatusNoContent}
+	case len(segments) == 2 && segments[1] == "entries" && r.Method == http.MethodPost:
+		var params struct {
+			Content string `json:"content"`
+		}
+
+		err := r.Decode(&params)
+		if err != nil {
+			return ErrorResponse(http.StatusBadRequest, err.Error())
+		}
+
+		glossary.EntriesCount = countEntries(params.Content)
+		s.glossaries[id] = glossary
+
+		return jsonResponse(glossary)
+	default:
+		return ErrorResponse(http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+
+	return fmt.Sprintf("%s-%d", prefix, s.nextID)
+}
+
+func jsonResponse(body interface{}) Response {
+	return Response{StatusCode: http.StatusOK, Body: body}
+}
+
+func writeResponse(w http.ResponseWriter, response Response) {
+	for name, values := range response.Header {
+		w.Header()[name] = values
+	}
+
+	var body []byte
+
+	switch b := response.Body.(type) {
+	case nil:
+	case []byte:
+		body = b
+	case string:
+		body = []byte(b)
+	default:
+		var err error
+
+		body, err = json.Marshal(b)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+	}
+
+	statusCode := response.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+// prefixTranslate is the default TranslateFunc.
+func prefixTranslate(text []string, from, to string) []string {
+	results := make([]string, len(text))
+	for i, item := range text {
+		results[i] = "[" + to + "] " + item
+	}
+
+	return results
+}
+
+// countEntries counts the non-empty lines of glossary content except the header.
+func countEntries(content string) int {
+	var count int
+
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) != "" {
+			count++
+		}
+	}
+
+	if count > 0 {
+		count--
+	}
+
+	return count
+}
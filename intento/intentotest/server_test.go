@@ -0,0 +1,117 @@
+package intentotest_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestServer_translate(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	result, err := client.Translate(ctx, []string{"Hello"}, intento.AutoDetectSourceLanguage, "es")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[es] Hello"}, result.Results)
+	assert.Equal(t, []string{"en"}, result.Meta.DetectedSourceLanguage)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, intentotest.APIKey, requests[0].Header.Get("apikey"))
+}
+
+func TestServer_translateAsync(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetPendingPolls(1)
+	server.SetTranslateFunc(func(text []string, from, to string) []string {
+		results := make([]string, len(text))
+		for i, item := range text {
+			results[i] = strings.ToUpper(item)
+		}
+
+		return results
+	})
+
+	client := server.Client()
+
+	operation, err := client.TranslateAsync(ctx, []string{"Hello"}, "en", "es")
+	assert.NoError(t, err)
+
+	operation, err = client.Wait(ctx, operation.ID, intento.WaitWithInterval(0, 0))
+	assert.NoError(t, err)
+
+	result, err := operation.TranslationResult()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"HELLO"}, result.Results)
+	assert.Len(t, server.Requests(), 3)
+}
+
+func TestServer_respond(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.Respond(
+		http.MethodGet,
+		"/ai/text/translate/languages",
+		intentotest.ErrorResponse(http.StatusTooManyRequests, "slow down"),
+	)
+	server.SetLanguages([]intento.Language{{IntentoCode: "es"}})
+
+	client := server.Client(intento.ClientWithRetryPolicy(intento.RetryPolicy{MaxAttempts: 2}))
+
+	languages, err := client.AvailableLanguages(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.Language{{IntentoCode: "es"}}, languages)
+	assert.Len(t, server.Requests(), 2)
+}
+
+func TestServer_glossaries(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	glossary, err := client.CreateGlossary(ctx, intento.GlossaryParams{Name: "terms"})
+	assert.NoError(t, err)
+
+	glossary, err = client.UpdateGlossary(ctx, glossary.ID, intento.GlossaryFormatCSV, strings.NewReader("en,es\nhello,hola\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, glossary.EntriesCount)
+
+	glossaries, err := client.Glossaries(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.Glossary{glossary}, glossaries)
+
+	assert.NoError(t, client.DeleteGlossary(ctx, glossary.ID))
+
+	_, err = client.Glossary(ctx, glossary.ID)
+
+	var notFoundError *intento.NotFoundError
+	assert.ErrorAs(t, err, &notFoundError)
+}
+
+func TestPseudoTranslator(t *testing.T) {
+	result, err := intentotest.PseudoTranslator{}.Translate(context.Background(), []string{"Save", ""}, "", "de")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[Šáṽé~~]", "[]"}, result.Results)
+	assert.Equal(t, []string{"en", "en"}, result.Meta.DetectedSourceLanguage)
+	assert.Equal(t, intentotest.PseudoProviderID, result.Service.Provider.ID)
+}
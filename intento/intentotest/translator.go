@@ -0,0 +1,76 @@
+package intentotest
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"intento-golang/intento"
+)
+
+// PseudoProviderID is the provider reported by PseudoTranslator.
+const PseudoProviderID = "intentotest.pseudo"
+
+// PseudoTranslator is a deterministic intento.Translator that
+// pseudo-localizes text instead of translating it, see Pseudolocalize.
+//
+// It needs no server, so it fits unit tests of code that depends on
+// intento.Translator as well as checking a UI for truncated or hard-coded
+// strings.
+type PseudoTranslator struct{}
+
+var _ intento.Translator = PseudoTranslator{}
+
+// Translate pseudo-localizes every text item. The detected source language
+// is from, or "en" if from is empty.
+func (PseudoTranslator) Translate(
+	ctx context.Context,
+	text []string,
+	from string,
+	to string,
+	options ...intento.TranslationOption,
+) (intento.TranslationResult, error) {
+	if err := ctx.Err(); err != nil {
+		return intento.TranslationResult{}, err
+	}
+
+	detected := from
+	if detected == intento.AutoDetectSourceLanguage {
+		detected = "en"
+	}
+
+	var result intento.TranslationResult
+	result.ID = PseudoProviderID
+	result.Results = make([]string, len(text))
+	result.Meta.DetectedSourceLanguage = make([]string, len(text))
+	result.Service.Provider.ID = PseudoProviderID
+	result.Service.Provider.Name = "Pseudo-localization"
+
+	for i, item := range text {
+		result.Results[i] = Pseudolocalize(item)
+		result.Meta.DetectedSourceLanguage[i] = detected
+	}
+
+	return result, nil
+}
+
+// pseudoLetters maps ASCII letters to accented look-alikes.
+var pseudoLetters = strings.NewReplacer(
+	"a", "á", "b", "ƀ", "c", "ç", "d", "ð", "e", "é", "f", "ƒ", "g", "ĝ",
+	"h", "ĥ", "i", "í", "j", "ĵ", "k", "ķ", "l", "ļ", "m", "ɱ", "n", "ñ",
+	"o", "ó", "p", "þ", "q", "ǫ", "r", "ŕ", "s", "š", "t", "ţ", "u", "ú",
+	"v", "ṽ", "w", "ŵ", "x", "ẋ", "y", "ý", "z", "ž",
+	"A", "Á", "B", "Ɓ", "C", "Ç", "D", "Ð", "E", "É", "F", "Ƒ", "G", "Ĝ",
+	"H", "Ĥ", "I", "Í", "J", "Ĵ", "K", "Ķ", "L", "Ļ", "M", "Ṁ", "N", "Ñ",
+	"O", "Ó", "P", "Þ", "Q", "Ǫ", "R", "Ŕ", "S", "Š", "T", "Ţ", "U", "Ú",
+	"V", "Ṽ", "W", "Ŵ", "X", "Ẋ", "Y", "Ý", "Z", "Ž",
+)
+
+// Pseudolocalize replaces the ASCII letters of s with accented ones, pads it
+// by a third of its length to mimic the expansion of translated text and
+// wraps it in brackets, e.g. "Save" becomes "[Šáṽé~~]".
+func Pseudolocalize(s string) string {
+	padding := (utf8.RuneCountInString(s) + 2) / 3
+
+	return "[" + pseudoLetters.Replace(s) + strings.Repeat("~", padding) + "]"
+}
@@ -0,0 +1,267 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LanguageResolver maps language tags to the codes of Intento and back.
+//
+// Tags are accepted in the BCP-47 form like "zh-Hant-TW" or "pt-BR", as well
+// as in the form of locale files like "en_US" or "de_DE.UTF-8".
+type LanguageResolver struct {
+	languages []Language
+	// codes are the Intento codes keyed by the normalized codes.
+	codes map[string]string
+}
+
+// NewLanguageResolver creates a LanguageResolver of the languages.
+func NewLanguageResolver(languages []Language) *LanguageResolver {
+	resolver := &LanguageResolver{
+		languages: languages,
+		codes:     make(map[string]string, len(languages)),
+	}
+
+	for _, language := range languages {
+		resolver.codes[normalizeLanguageTag(language.IntentoCode)] = language.IntentoCode
+	}
+
+	return resolver
+}
+
+// LanguageResolver requests the available languages and builds a
+// LanguageResolver of them.
+func (c *Client) LanguageResolver(ctx context.Context) (*LanguageResolver, error) {
+	languages, err := c.AvailableLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLanguageResolver(languages), nil
+}
+
+// Resolve returns the Intento code of the language tag.
+//
+// If there is no exact match, the tag is tried without the region and with
+// the script inferred from the region, e.g. "zh-TW" matches "zh-Hant",
+// then the alternative language codes are tried, e.g. "iw" matches "he".
+func (r *LanguageResolver) Resolve(tag string) (string, bool) {
+	for _, candidate := range languageCandidates(tag) {
+		code, ok := r.codes[candidate]
+		if ok {
+			return code, true
+		}
+	}
+
+	return "", false
+}
+
+// Tag returns the BCP-47 tag of the Intento code, e.g. "pt-BR" for "pt-br".
+func (r *LanguageResolver) Tag(code string) (string, bool) {
+	code, ok := r.code(code)
+	if !ok {
+		return "", false
+	}
+
+	subtags := strings.Split(code, "-")
+	subtags[0] = strings.ToLower(subtags[0])
+
+	for i := 1; i < len(subtags); i++ {
+		switch len(subtags[i]) {
+		case 2:
+			subtags[i] = strings.ToUpper(subtags[i])
+		case 4:
+			subtags[i] = strings.ToUpper(subtags[i][:1]) + strings.ToLower(subtags[i][1:])
+		default:
+			subtags[i] = strings.ToLower(subtags[i])
+		}
+	}
+
+	return strings.Join(subtags, "-"), true
+}
+
+// Languages returns the languages of the resolver.
+func (r *LanguageResolver) Languages() []Language {
+	return r.languages
+}
+
+// resolveLanguageTags replaces the language tags of the translation with the
+// codes of Intento.
+func (c *Client) resolveLanguageTags(ctx context.Context, params *translationOptions) error {
+	resolver, err := c.catalog.languageResolver(ctx, c)
+	if err != nil {
+		return fmt.Errorf("get languages: %w", err)
+	}
+
+	if params.Context.From != AutoDetectSourceLanguage {
+		code, ok := resolver.Resolve(params.Context.From)
+		if !ok {
+			return &LanguageNotSupportedError{Tag: params.Context.From}
+		}
+
+		params.Context.From = code
+	}
+
+	code, ok := resolver.Resolve(params.Context.To)
+	if !ok {
+		return &LanguageNotSupportedError{Tag: params.Context.To}
+	}
+
+	params.Context.To = code
+
+	return nil
+}
+
+// code returns the Intento code written as code in any case, e.g. "pt-br"
+// for "PT-BR". Unlike Resolve, no other code is tried.
+func (r *LanguageResolver) code(code string) (string, bool) {
+	code, ok := r.codes[normalizeLanguageTag(code)]
+
+	return code, ok
+}
+
+// languageTag is a language tag split into the subtags Intento cares about.
+type languageTag struct {
+	language string
+	script   string
+	region   string
+}
+
+// parseLanguageTag splits a normalized tag, variants and extensions are dropped.
+func parseLanguageTag(tag string) languageTag {
+	subtags := strings.Split(tag, "-")
+
+	parsed := languageTag{language: subtags[0]}
+
+	for _, subtag := range subtags[1:] {
+		switch {
+		case len(subtag) == 4 && parsed.script == "" && parsed.region == "" && isLetters(subtag):
+			parsed.script = subtag
+		case (len(subtag) == 2 && isLetters(subtag) || len(subtag) == 3 && isDigits(subtag)) && parsed.region == "":
+			parsed.region = subtag
+		case len(subtag) == 1:
+			// An extension or a private use subtag, the rest is not a region.
+			return parsed
+		}
+	}
+
+	return parsed
+}
+
+// languageCandidates returns the normalized codes to look the tag up by,
+// from the most to the least specific.
+func languageCandidates(tag string) []string {
+	parsed := parseLanguageTag(normalizeLanguageTag(tag))
+	if parsed.language == "" {
+		return nil
+	}
+
+	languages := []string{parsed.language}
+	if alias, ok := languageAliases[parsed.language]; ok {
+		languages = append(languages, alias)
+	}
+
+	var candidates []string
+
+	add := func(subtags ...string) {
+		var parts []string
+		for _, subtag := range subtags {
+			if subtag != "" {
+				parts = append(parts, subtag)
+			}
+		}
+
+		candidate := strings.Join(parts, "-")
+		for _, existing := range candidates {
+			if existing == candidate {
+				return
+			}
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	for _, language := range languages {
+		script := parsed.script
+		if script == "" {
+			script = regionScripts[language][parsed.region]
+		}
+
+		region := parsed.region
+		if region == "" {
+			region = scriptRegions[language][script]
+		}
+
+		add(language, parsed.script, parsed.region)
+		add(language, script, parsed.region)
+		if parsed.region != "" {
+			add(language, parsed.region)
+		}
+		add(language, script)
+		add(language, region)
+		add(language)
+	}
+
+	return candidates
+}
+
+// normalizeLanguageTag lowercases the tag, replaces underscores with hyphens
+// and drops the encoding and the modifier of locale names like "de_DE.UTF-8@euro".
+func normalizeLanguageTag(tag string) string {
+	tag = strings.TrimSpace(tag)
+
+	if i := strings.IndexAny(tag, ".@"); i >= 0 {
+		tag = tag[:i]
+	}
+
+	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// regionScripts are the scripts implied by the regions for the languages
+// written in several scripts, the empty region stands for the default script.
+var regionScripts = map[string]map[string]string{
+	"zh": {"": "hans", "cn": "hans", "sg": "hans", "my": "hans", "tw": "hant", "hk": "hant", "mo": "hant"},
+	"sr": {"rs": "cyrl", "me": "latn", "ba": "cyrl"},
+	"pa": {"in": "guru", "pk": "arab"},
+	"uz": {"uz": "latn", "af": "arab"},
+	"az": {"az": "latn", "ir": "arab"},
+}
+
+// scriptRegions are the regions implied by the scripts, the inverse of
+// regionScripts for the regions Intento uses.
+var scriptRegions = map[string]map[string]string{
+	"zh": {"hans": "cn", "hant": "tw"},
+}
+
+// languageAliases are the alternative codes of languages, mostly deprecated
+// ones still found in locale files.
+var languageAliases = map[string]string{
+	"iw": "he",
+	"in": "id",
+	"ji": "yi",
+	"jw": "jv",
+	"mo": "ro",
+	"no": "nb",
+	"nb": "no",
+}
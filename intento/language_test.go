@@ -0,0 +1,121 @@
+package intento_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func testLanguages() []intento.Language {
+	return []intento.Language{
+		{IntentoCode: "en"},
+		{IntentoCode: "de"},
+		{IntentoCode: "he"},
+		{IntentoCode: "pt"},
+		{IntentoCode: "pt-br"},
+		{IntentoCode: "zh"},
+		{IntentoCode: "zh-Hant"},
+		{IntentoCode: "sr-Latn"},
+	}
+}
+
+func TestLanguageResolver_Resolve(t *testing.T) {
+	resolver := intento.NewLanguageResolver(testLanguages())
+
+	for tag, want := range map[string]string{
+		"en":             "en",
+		"en-US":          "en",
+		"en_US":          "en",
+		"de_DE.UTF-8":    "de",
+		"de_DE@euro":     "de",
+		"pt-BR":          "pt-br",
+		"pt_PT":          "pt",
+		"zh":             "zh",
+		"zh-CN":          "zh",
+		"zh-TW":          "zh-Hant",
+		"zh-Hant-TW":     "zh-Hant",
+		"zh-hant":        "zh-Hant",
+		"iw":             "he",
+		"sr-Latn-RS":     "sr-Latn",
+		"en-US-x-custom": "en",
+	} {
+		code, ok := resolver.Resolve(tag)
+		assert.True(t, ok, tag)
+		assert.Equal(t, want, code, tag)
+	}
+
+	for _, tag := range []string{"", "fr", "sr", "es-419"} {
+		_, ok := resolver.Resolve(tag)
+		assert.False(t, ok, tag)
+	}
+}
+
+func TestLanguageResolver_Tag(t *testing.T) {
+	resolver := intento.NewLanguageResolver(testLanguages())
+
+	for code, want := range map[string]string{
+		"en":      "en",
+		"pt-br":   "pt-BR",
+		"zh-Hant": "zh-Hant",
+		"sr-latn": "sr-Latn",
+	} {
+		tag, ok := resolver.Tag(code)
+		assert.True(t, ok, code)
+		assert.Equal(t, want, tag, code)
+	}
+
+	_, ok := resolver.Tag("fr")
+	assert.False(t, ok)
+}
+
+func TestTranslationWithLanguageTags(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetLanguages(testLanguages())
+
+	client := server.Client()
+
+	result, err := client.Translate(ctx, []string{"Hello"}, "en_US", "zh-TW", intento.TranslationWithLanguageTags())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[zh-Hant] Hello"}, result.Results)
+
+	result, err = client.Translate(ctx, []string{"Hello"}, "", "pt-BR", intento.TranslationWithLanguageTags())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[pt-br] Hello"}, result.Results)
+
+	_, err = client.Translate(ctx, []string{"Hello"}, "en", "fr-CA", intento.TranslationWithLanguageTags())
+
+	var languageError *intento.LanguageNotSupportedError
+	if assert.ErrorAs(t, err, &languageError) {
+		assert.Equal(t, "fr-CA", languageError.Tag)
+	}
+
+	assert.Len(t, server.Requests(), 3, "the languages are requested once")
+}
+
+func TestTranslationWithLanguageTags_cache(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetLanguages(testLanguages())
+
+	client := server.Client(intento.ClientWithCache(intento.NewMemoryCache(100, time.Hour)))
+
+	for _, tag := range []string{"pt-BR", "pt_BR", "pt-br"} {
+		result, err := client.Translate(ctx, []string{"Hello"}, "en", tag, intento.TranslationWithLanguageTags())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"[pt-br] Hello"}, result.Results)
+	}
+
+	assert.Len(t, server.Requests(), 2, "the tags resolved to the same code share the cache")
+}
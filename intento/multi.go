@@ -0,0 +1,108 @@
+package intento
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultMultiConcurrency is the number of requests TranslateMulti sends at
+// once unless the concurrency is given.
+const defaultMultiConcurrency = 4
+
+// MultiTranslationResult is the result of translation into one of the target languages.
+type MultiTranslationResult struct {
+	Result TranslationResult
+	Err    error
+}
+
+// TranslateMulti translates text into several target languages concurrently.
+//
+// At most concurrency requests are sent at once, zero or less means the
+// default of 4. The returned map is keyed by the target language. If the source language
+// is autodetected, the first target is translated before the others and the
+// language it detected is reused, provided all text items are detected as the
+// same language.
+func (c *Client) TranslateMulti(
+	ctx context.Context,
+	text []string,
+	from string,
+	to []string,
+	concurrency int,
+	options ...TranslationOption,
+) map[string]MultiTranslationResult {
+	results := make(map[string]MultiTranslationResult, len(to))
+	if len(to) == 0 {
+		return results
+	}
+
+	if concurrency < 1 {
+		concurrency = defaultMultiConcurrency
+	}
+
+	targets := to
+
+	if from == AutoDetectSourceLanguage {
+		result, err := c.Translate(ctx, text, from, to[0], options...)
+		results[to[0]] = MultiTranslationResult{Result: result, Err: err}
+		targets = to[1:]
+
+		if err == nil {
+			from = commonLanguage(result.Meta.DetectedSourceLanguage)
+		}
+	}
+
+	seen := map[string]bool{to[0]: len(results) > 0}
+	mu := sync.Mutex{}
+	semaphore := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+
+	for _, target := range targets {
+		if seen[target] {
+			continue
+		}
+
+		seen[target] = true
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			results[target] = MultiTranslationResult{Err: ctx.Err()}
+			mu.Unlock()
+
+			continue
+		}
+
+		wg.Add(1)
+		go func(target string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result, err := c.Translate(ctx, text, from, target, options...)
+
+			mu.Lock()
+			results[target] = MultiTranslationResult{Result: result, Err: err}
+			mu.Unlock()
+		}(target)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// commonLanguage returns the language if all detected languages are the same,
+// and AutoDetectSourceLanguage otherwise.
+func commonLanguage(detected []string) string {
+	if len(detected) == 0 {
+		return AutoDetectSourceLanguage
+	}
+
+	for _, language := range detected[1:] {
+		if language != detected[0] {
+			return AutoDetectSourceLanguage
+		}
+	}
+
+	return detected[0]
+}
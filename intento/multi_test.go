@@ -0,0 +1,75 @@
+package intento_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestClient_TranslateMulti(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.Respond(
+		http.MethodPost,
+		"/ai/text/translate",
+		intentotest.Response{Body: `{"results":["Hola"],"meta":{"detected_source_language":["en"]}}`},
+		intentotest.ErrorResponse(http.StatusBadRequest, "unsupported language"),
+	)
+
+	client := server.Client()
+
+	results := client.TranslateMulti(
+		ctx,
+		[]string{"Hello"},
+		intento.AutoDetectSourceLanguage,
+		[]string{"es", "xx", "xx"},
+		2,
+	)
+
+	assert.Len(t, results, 2)
+	assert.NoError(t, results["es"].Err)
+	assert.Equal(t, []string{"Hola"}, results["es"].Result.Results)
+
+	var providerRelatedError *intento.ProviderRelatedError
+	assert.ErrorAs(t, results["xx"].Err, &providerRelatedError)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 2)
+
+	var params struct {
+		Context struct {
+			From string `json:"from"`
+		} `json:"context"`
+	}
+	assert.NoError(t, json.Unmarshal(requests[1].Body, &params))
+	assert.Equal(t, "en", params.Context.From)
+}
+
+func TestClient_TranslateMulti_concurrent(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	targets := []string{"de", "es", "fr", "it", "ja", "pt"}
+
+	results := client.TranslateMulti(ctx, []string{"Hello"}, "en", targets, 0)
+
+	assert.Len(t, results, len(targets))
+
+	for _, target := range targets {
+		assert.NoError(t, results[target].Err)
+		assert.Equal(t, []string{"[" + target + "] Hello"}, results[target].Result.Results)
+	}
+}
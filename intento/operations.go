@@ -0,0 +1,204 @@
+package intento
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Operation describes an asynchronous operation.
+type Operation struct {
+	ID       string          `json:"id"`
+	Done     bool            `json:"done"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    *OperationError `json:"error,omitempty"`
+}
+
+// OperationError describes the reason an asynchronous operation has failed.
+type OperationError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("intento: operation failed: %d %s", e.Code, e.Message)
+}
+
+// DecodeResponse unmarshals the response of a finished operation into result.
+func (o Operation) DecodeResponse(result interface{}) error {
+	if !o.Done {
+		return fmt.Errorf("intento: operation %s is not done", o.ID)
+	}
+
+	if o.Error != nil {
+		return o.Error
+	}
+
+	err := json.Unmarshal(o.Response, result)
+	if err != nil {
+		return fmt.Errorf("unmarshal operation response: %w", err)
+	}
+
+	return nil
+}
+
+// TranslationResult returns the result of a finished asynchronous translation.
+func (o Operation) TranslationResult() (TranslationResult, error) {
+	var results []TranslationResult
+
+	err := o.DecodeResponse(&results)
+	if err != nil {
+		return TranslationResult{}, err
+	}
+
+	if len(results) == 0 {
+		return TranslationResult{}, fmt.Errorf("intento: operation %s has empty response", o.ID)
+	}
+
+	result := results[0]
+	result.ID = o.ID
+
+	return result, nil
+}
+
+// Operation returns the current state of the asynchronous operation.
+func (c *Client) Operation(ctx context.Context, id string) (Operation, error) {
+	var operation Operation
+
+	err := c.apiGetRequest(ctx, c.url(endpointOperation, id), &operation)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	return operation, nil
+}
+
+// Wait polls the asynchronous operation until it is done, failed or the
+// context is cancelled.
+//
+// The delay between polls starts at the initial interval and is multiplied
+// on every attempt until it reaches the maximum interval, see
+// WaitWithInterval and WaitWithBackoffMultiplier.
+func (c *Client) Wait(ctx context.Context, id string, options ...WaitOption) (Operation, error) {
+	params := defaultWaitOptions()
+
+	for _, opt := range options {
+		opt.apply(&params)
+	}
+
+	params.normalize()
+
+	delay := params.initialInterval
+
+	for {
+		operation, err := c.Operation(ctx, id)
+		if err != nil {
+			return Operation{}, fmt.Errorf("get operation: %w", err)
+		}
+
+		if operation.Done {
+			if operation.Error != nil {
+				return operation, operation.Error
+			}
+
+			return operation, nil
+		}
+
+		err = sleepContext(ctx, delay)
+		if err != nil {
+			return operation, err
+		}
+
+		delay = time.Duration(float64(delay) * params.multiplier)
+		if delay > params.maxInterval {
+			delay = params.maxInterval
+		}
+	}
+}
+
+// WaitWithInterval sets the initial and the maximum delay between polls.
+//
+// Delays shorter than 100ms are raised to it, so the operation is never polled
+// in a tight loop.
+func WaitWithInterval(initial, max time.Duration) WaitOption {
+	return newFuncWaitOption(func(o *waitOptions) {
+		o.initialInterval = initial
+		o.maxInterval = max
+	})
+}
+
+// WaitWithBackoffMultiplier sets the factor the delay between polls is
+// multiplied by after every poll, a factor less than 1 is treated as 1.
+func WaitWithBackoffMultiplier(multiplier float64) WaitOption {
+	return newFuncWaitOption(func(o *waitOptions) {
+		o.multiplier = multiplier
+	})
+}
+
+// WaitOption configures how we poll an operation.
+type WaitOption interface {
+	apply(*waitOptions)
+}
+
+// waitOptions configure a polling process.
+type waitOptions struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	multiplier      float64
+}
+
+// minWaitInterval is the shortest delay between polls.
+const minWaitInterval = 100 * time.Millisecond
+
+// normalize keeps the delay between polls from shrinking below minWaitInterval.
+func (o *waitOptions) normalize() {
+	if o.initialInterval < minWaitInterval {
+		o.initialInterval = minWaitInterval
+	}
+
+	if o.maxInterval < o.initialInterval {
+		o.maxInterval = o.initialInterval
+	}
+
+	if o.multiplier < 1 {
+		o.multiplier = 1
+	}
+}
+
+func defaultWaitOptions() waitOptions {
+	return waitOptions{
+		initialInterval: 500 * time.Millisecond,
+		maxInterval:     10 * time.Second,
+		multiplier:      1.5,
+	}
+}
+
+// funcWaitOption wraps a function that modifies waitOptions into an implementation of the WaitOption interface.
+type funcWaitOption struct {
+	fn func(*waitOptions)
+}
+
+func (fwo *funcWaitOption) apply(do *waitOptions) {
+	fwo.fn(do)
+}
+
+func newFuncWaitOption(fn func(*waitOptions)) *funcWaitOption {
+	return &funcWaitOption{
+		fn: fn,
+	}
+}
+
+// sleepContext pauses the current goroutine for the duration d or until the
+// context is cancelled.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
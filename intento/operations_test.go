@@ -0,0 +1,105 @@
+package intento_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_TranslateAsync(t *testing.T) {
+	ctx := context.Background()
+
+	responses := []string{
+		`{"id":"op-1"}`,
+		`{"id":"op-1","done":false}`,
+		`{"id":"op-1","done":true,"response":[{"results":["Hola Mundo!"]}]}`,
+	}
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body := responses[0]
+			responses = responses[1:]
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	operation, err := client.TranslateAsync(ctx, text, "en", "es")
+	assert.NoError(t, err)
+	assert.Equal(t, "op-1", operation.ID)
+
+	operation, err = client.Wait(ctx, operation.ID, intento.WaitWithInterval(time.Millisecond, time.Millisecond))
+	assert.NoError(t, err)
+	assert.True(t, operation.Done)
+
+	result, err := operation.TranslationResult()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Hola Mundo!"}, result.Results)
+
+	calls := mockHttpClient.DoCalls()
+	assert.Len(t, calls, 3)
+	assert.Equal(t, "/operations/op-1", calls[2].Req.URL.Path)
+}
+
+func TestClient_Wait_failed(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`{"id":"op-1","done":true,"error":{"code":500,"message":"boom"}}`)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	_, err := client.Wait(ctx, "op-1")
+
+	var operationError *intento.OperationError
+	assert.ErrorAs(t, err, &operationError)
+	assert.Equal(t, "boom", operationError.Message)
+}
+
+func TestClient_Wait_minInterval(t *testing.T) {
+	ctx := context.Background()
+
+	polls := 0
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			polls++
+
+			body := `{"id":"op-1","done":false}`
+			if polls == 3 {
+				body = `{"id":"op-1","done":true}`
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	start := time.Now()
+
+	_, err := client.Wait(ctx, "op-1", intento.WaitWithInterval(0, 0), intento.WaitWithBackoffMultiplier(0.1))
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
@@ -0,0 +1,259 @@
+package intento
+
+import (
+	"context"
+	"sort"
+)
+
+// ProviderCatalog answers questions about the capabilities of providers, it
+// is built from the response of Client.AvailableProviders.
+type ProviderCatalog struct {
+	providers []Provider
+	index     map[string]int
+}
+
+// NewProviderCatalog creates a ProviderCatalog of the providers.
+func NewProviderCatalog(providers []Provider) *ProviderCatalog {
+	catalog := &ProviderCatalog{
+		providers: providers,
+		index:     make(map[string]int, len(providers)),
+	}
+
+	for i, provider := range providers {
+		catalog.index[provider.ID] = i
+	}
+
+	return catalog
+}
+
+// ProviderCatalog requests the available providers and builds a
+// ProviderCatalog of them.
+func (c *Client) ProviderCatalog(ctx context.Context) (*ProviderCatalog, error) {
+	providers, err := c.AvailableProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewProviderCatalog(providers), nil
+}
+
+// Providers returns all providers of the catalog.
+func (c *ProviderCatalog) Providers() []Provider {
+	return c.providers
+}
+
+// Provider returns the provider with given ID.
+func (c *ProviderCatalog) Provider(id string) (Provider, bool) {
+	i, ok := c.index[id]
+	if !ok {
+		return Provider{}, false
+	}
+
+	return c.providers[i], true
+}
+
+// SupportsPair reports whether any provider translates from one language to
+// the other, see Provider.SupportsPair.
+func (c *ProviderCatalog) SupportsPair(from, to string) bool {
+	for _, provider := range c.providers {
+		if provider.SupportsPair(from, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ProvidersFor returns the providers that translate from one language to the
+// other and pass all filters, in the order of the catalog. If both languages
+// are empty, every provider passing the filters is returned.
+func (c *ProviderCatalog) ProvidersFor(from, to string, filters ...ProviderFilter) []Provider {
+	var providers []Provider
+
+	for _, provider := range c.providers {
+		if (from != "" || to != "") && !provider.SupportsPair(from, to) {
+			continue
+		}
+
+		if matchProvider(provider, filters) {
+			providers = append(providers, provider)
+		}
+	}
+
+	return providers
+}
+
+// Cheapest returns the provider with the lowest price among ProvidersFor,
+// ties are broken by the higher score. A zero price is one the catalog does
+// not report, such providers are chosen only if no other price is known.
+func (c *ProviderCatalog) Cheapest(from, to string, filters ...ProviderFilter) (Provider, bool) {
+	return c.best(from, to, filters, func(a, b Provider) bool {
+		if a.Price != b.Price {
+			return lowerPrice(a.Price, b.Price)
+		}
+
+		return a.Score > b.Score
+	})
+}
+
+// BestScored returns the provider with the highest score among ProvidersFor,
+// ties are broken by the lower price the way Cheapest compares them.
+func (c *ProviderCatalog) BestScored(from, to string, filters ...ProviderFilter) (Provider, bool) {
+	return c.best(from, to, filters, func(a, b Provider) bool {
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+
+		return lowerPrice(a.Price, b.Price)
+	})
+}
+
+// lowerPrice reports whether price a is lower than b, the zero price is not
+// known and is higher than any other.
+func lowerPrice(a, b int) bool {
+	if a == 0 || b == 0 {
+		return b == 0 && a != 0
+	}
+
+	return a < b
+}
+
+// LanguagePairs returns the pairs supported by any provider, sorted.
+func (c *ProviderCatalog) LanguagePairs() []LanguagePair {
+	seen := make(map[LanguagePair]bool)
+
+	var pairs []LanguagePair
+
+	for _, provider := range c.providers {
+		for _, pair := range provider.LanguagePairs() {
+			if !seen[pair] {
+				seen[pair] = true
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+
+	sortLanguagePairs(pairs)
+
+	return pairs
+}
+
+// best returns the first provider that no other is better than, ties of less
+// are broken by the ID to keep the choice stable.
+func (c *ProviderCatalog) best(from, to string, filters []ProviderFilter, less func(a, b Provider) bool) (Provider, bool) {
+	providers := c.ProvidersFor(from, to, filters...)
+	if len(providers) == 0 {
+		return Provider{}, false
+	}
+
+	best := providers[0]
+
+	for _, provider := range providers[1:] {
+		if less(provider, best) || (!less(best, provider) && provider.ID < best.ID) {
+			best = provider
+		}
+	}
+
+	return best, true
+}
+
+// SupportsPair reports whether the provider translates from one language to
+// the other, either as one of Pairs or as two different Symmetric languages.
+// An empty language matches any, e.g. an empty from means the source language
+// is autodetected.
+func (p Provider) SupportsPair(from, to string) bool {
+	for _, pair := range p.Pairs {
+		if (from == "" || pair.From == from) && (to == "" || pair.To == to) {
+			return true
+		}
+	}
+
+	if len(p.Symmetric) < 2 || (from != "" && from == to) {
+		return false
+	}
+
+	var hasFrom, hasTo bool
+
+	for _, language := range p.Symmetric {
+		hasFrom = hasFrom || language == from
+		hasTo = hasTo || language == to
+	}
+
+	return (from == "" || hasFrom) && (to == "" || hasTo)
+}
+
+// LanguagePairs returns Pairs together with every pair of different Symmetric
+// languages, sorted and without duplicates.
+func (p Provider) LanguagePairs() []LanguagePair {
+	seen := make(map[LanguagePair]bool, len(p.Pairs)+len(p.Symmetric)*len(p.Symmetric))
+
+	var pairs []LanguagePair
+
+	add := func(pair LanguagePair) {
+		if !seen[pair] {
+			seen[pair] = true
+			pairs = append(pairs, pair)
+		}
+	}
+
+	for _, pair := range p.Pairs {
+		add(pair)
+	}
+
+	for _, from := range p.Symmetric {
+		for _, to := range p.Symmetric {
+			if from != to {
+				add(LanguagePair{From: from, To: to})
+			}
+		}
+	}
+
+	sortLanguagePairs(pairs)
+
+	return pairs
+}
+
+// ProviderFilter selects providers of a ProviderCatalog.
+type ProviderFilter func(provider Provider) bool
+
+// ProviderProduction selects the providers ready for production.
+func ProviderProduction() ProviderFilter {
+	return func(provider Provider) bool {
+		return provider.Production
+	}
+}
+
+// ProviderCustomModel selects the providers supporting custom models.
+func ProviderCustomModel() ProviderFilter {
+	return func(provider Provider) bool {
+		return provider.CustomModel
+	}
+}
+
+// ProviderAsyncOnly selects the providers that work in the asynchronous mode
+// only, or with false, the providers that also work in the synchronous mode.
+func ProviderAsyncOnly(asyncOnly bool) ProviderFilter {
+	return func(provider Provider) bool {
+		return provider.AsyncOnly == asyncOnly
+	}
+}
+
+func matchProvider(provider Provider, filters []ProviderFilter) bool {
+	for _, filter := range filters {
+		if !filter(provider) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortLanguagePairs(pairs []LanguagePair) {
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].From != pairs[j].From {
+			return pairs[i].From < pairs[j].From
+		}
+
+		return pairs[i].To < pairs[j].To
+	})
+}
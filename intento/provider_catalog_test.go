@@ -0,0 +1,138 @@
+package intento_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func testProviders() []intento.Provider {
+	return []intento.Provider{
+		{
+			ID:         "p1",
+			Production: true,
+			Price:      20,
+			Score:      5,
+			Pairs:      []intento.LanguagePair{{From: "en", To: "de"}, {From: "en", To: "fr"}},
+		},
+		{
+			ID:          "p2",
+			Production:  true,
+			CustomModel: true,
+			Price:       10,
+			Score:       3,
+			Symmetric:   []string{"en", "de", "es"},
+		},
+		{
+			ID:        "p3",
+			AsyncOnly: true,
+			Price:     10,
+			Score:     5,
+			Pairs:     []intento.LanguagePair{{From: "en", To: "de"}},
+		},
+	}
+}
+
+func TestProviderCatalog_ProvidersFor(t *testing.T) {
+	catalog := intento.NewProviderCatalog(testProviders())
+
+	ids := func(providers []intento.Provider) []string {
+		var ids []string
+		for _, provider := range providers {
+			ids = append(ids, provider.ID)
+		}
+
+		return ids
+	}
+
+	assert.Equal(t, []string{"p1", "p2", "p3"}, ids(catalog.ProvidersFor("en", "de")))
+	assert.Equal(t, []string{"p2"}, ids(catalog.ProvidersFor("de", "es")))
+	assert.Equal(t, []string{"p1", "p2", "p3"}, ids(catalog.ProvidersFor("", "de")))
+	assert.Equal(t, []string{"p1", "p2"}, ids(catalog.ProvidersFor("en", "de", intento.ProviderProduction())))
+	assert.Equal(t, []string{"p1", "p2"}, ids(catalog.ProvidersFor("en", "de", intento.ProviderAsyncOnly(false))))
+	assert.Equal(t, []string{"p2"}, ids(catalog.ProvidersFor("", "", intento.ProviderCustomModel())))
+	assert.Empty(t, catalog.ProvidersFor("de", "de"))
+
+	assert.True(t, catalog.SupportsPair("es", "en"))
+	assert.False(t, catalog.SupportsPair("fr", "en"))
+
+	provider, ok := catalog.Provider("p2")
+	assert.True(t, ok)
+	assert.Equal(t, "p2", provider.ID)
+
+	_, ok = catalog.Provider("p4")
+	assert.False(t, ok)
+}
+
+func TestProviderCatalog_best(t *testing.T) {
+	catalog := intento.NewProviderCatalog(testProviders())
+
+	cheapest, ok := catalog.Cheapest("en", "de")
+	assert.True(t, ok)
+	assert.Equal(t, "p3", cheapest.ID)
+
+	cheapest, ok = catalog.Cheapest("en", "de", intento.ProviderProduction())
+	assert.True(t, ok)
+	assert.Equal(t, "p2", cheapest.ID)
+
+	best, ok := catalog.BestScored("en", "de")
+	assert.True(t, ok)
+	assert.Equal(t, "p3", best.ID)
+
+	best, ok = catalog.BestScored("en", "fr")
+	assert.True(t, ok)
+	assert.Equal(t, "p1", best.ID)
+
+	_, ok = catalog.BestScored("fr", "de")
+	assert.False(t, ok)
+
+	unpriced := intento.Provider{ID: "p4", Score: 5, Pairs: []intento.LanguagePair{{From: "en", To: "de"}}}
+
+	catalog = intento.NewProviderCatalog(append(testProviders(), unpriced))
+
+	cheapest, ok = catalog.Cheapest("en", "de")
+	assert.True(t, ok)
+	assert.Equal(t, "p3", cheapest.ID, "an unknown price is not the lowest")
+
+	best, ok = catalog.BestScored("en", "de")
+	assert.True(t, ok)
+	assert.Equal(t, "p3", best.ID)
+
+	catalog = intento.NewProviderCatalog([]intento.Provider{unpriced})
+
+	cheapest, ok = catalog.Cheapest("en", "de")
+	assert.True(t, ok)
+	assert.Equal(t, "p4", cheapest.ID)
+}
+
+func TestProviderCatalog_LanguagePairs(t *testing.T) {
+	provider := testProviders()[1]
+	provider.Pairs = []intento.LanguagePair{{From: "en", To: "de"}, {From: "en", To: "ja"}}
+
+	assert.Equal(t, []intento.LanguagePair{
+		{From: "de", To: "en"},
+		{From: "de", To: "es"},
+		{From: "en", To: "de"},
+		{From: "en", To: "es"},
+		{From: "en", To: "ja"},
+		{From: "es", To: "de"},
+		{From: "es", To: "en"},
+	}, provider.LanguagePairs())
+
+	assert.Len(t, intento.NewProviderCatalog(testProviders()).LanguagePairs(), 7)
+}
+
+func TestClient_ProviderCatalog(t *testing.T) {
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.SetProviders(testProviders())
+
+	catalog, err := server.Client().ProviderCatalog(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, catalog.Providers(), 3)
+}
@@ -0,0 +1,188 @@
+package intento
+
+import (
+	"context"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// RateLimitStats describes how long callers waited for the client-side rate limiter.
+type RateLimitStats struct {
+	// Waits is the number of calls that had to wait for capacity.
+	Waits int64
+	// Waiting is the number of calls waiting for capacity right now.
+	Waiting int64
+	// TotalWait is the overall time spent waiting.
+	TotalWait time.Duration
+	// MaxWait is the longest single wait.
+	MaxWait time.Duration
+}
+
+// RateLimitStats returns the statistics of the client-side rate limiter, see
+// ClientWithRateLimit.
+func (c *Client) RateLimitStats() RateLimitStats {
+	return c.rateLimiter.stats()
+}
+
+// rateLimiter limits requests per second and characters per minute, a nil
+// rateLimiter does not limit anything.
+type rateLimiter struct {
+	requests   *tokenBucket
+	characters *tokenBucket
+
+	mu         sync.Mutex
+	statistics RateLimitStats
+}
+
+func newRateLimiter(requestsPerSecond float64, charactersPerMinute int) *rateLimiter {
+	limiter := &rateLimiter{}
+
+	if requestsPerSecond > 0 {
+		burst := requestsPerSecond
+		if burst < 1 {
+			burst = 1
+		}
+
+		limiter.requests = newTokenBucket(requestsPerSecond, burst)
+	}
+
+	if charactersPerMinute > 0 {
+		limiter.characters = newTokenBucket(float64(charactersPerMinute)/60, float64(charactersPerMinute))
+	}
+
+	return limiter
+}
+
+// waitRequest blocks until a request can be sent.
+func (l *rateLimiter) waitRequest(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	return l.wait(ctx, l.requests, 1)
+}
+
+// waitCharacters blocks until the text can be sent.
+func (l *rateLimiter) waitCharacters(ctx context.Context, text []string) error {
+	if l == nil {
+		return nil
+	}
+
+	return l.wait(ctx, l.characters, float64(countCharacters(text)))
+}
+
+func (l *rateLimiter) wait(ctx context.Context, bucket *tokenBucket, n float64) error {
+	if bucket == nil || n == 0 {
+		return nil
+	}
+
+	delay := bucket.reserve(n)
+	if delay <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	l.statistics.Waiting++
+	l.mu.Unlock()
+
+	start := time.Now()
+	err := sleepContext(ctx, delay)
+	waited := time.Since(start)
+
+	l.mu.Lock()
+	l.statistics.Waiting--
+	l.statistics.Waits++
+	l.statistics.TotalWait += waited
+	if waited > l.statistics.MaxWait {
+		l.statistics.MaxWait = waited
+	}
+	l.mu.Unlock()
+
+	if err != nil {
+		bucket.cancel(n)
+		return err
+	}
+
+	return nil
+}
+
+func (l *rateLimiter) stats() RateLimitStats {
+	if l == nil {
+		return RateLimitStats{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.statistics
+}
+
+// tokenBucket is a token bucket that lets callers reserve tokens in advance,
+// the balance goes negative while reserved tokens are being refilled.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst float64) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes n tokens and returns how long the caller has to wait for them.
+func (b *tokenBucket) reserve(n float64) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	b.tokens -= n
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns tokens of a reservation that was not used.
+func (b *tokenBucket) cancel(n float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	b.tokens += n
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *tokenBucket) refill() {
+	now := time.Now()
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	b.last = now
+}
+
+// countCharacters returns the number of characters in the text.
+func countCharacters(text []string) int {
+	var count int
+
+	for _, s := range text {
+		count += utf8.RuneCountInString(s)
+	}
+
+	return count
+}
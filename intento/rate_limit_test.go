@@ -0,0 +1,47 @@
+package intento_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_rateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`{"results":["Hola"]}`)),
+			}, nil
+		},
+	}
+
+	client := intento.New(
+		"api_key_1",
+		intento.ClientWithHttpClient(mockHttpClient),
+		intento.ClientWithRateLimit(0, 12),
+	)
+
+	_, err := client.Translate(ctx, text, "en", "es")
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Translate(ctx, text, "en", "es")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	stats := client.RateLimitStats()
+	assert.Equal(t, int64(1), stats.Waits)
+	assert.Equal(t, int64(0), stats.Waiting)
+	assert.Len(t, mockHttpClient.DoCalls(), 1)
+}
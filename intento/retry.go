@@ -0,0 +1,92 @@
+package intento
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy describes how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, it doubles on every
+	// following retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between retries, including the one requested
+	// by the Retry-After header.
+	MaxDelay time.Duration
+	// Jitter is the fraction of the delay, from 0 to 1, that is randomized to
+	// spread retries of concurrent requests.
+	Jitter float64
+	// Retriable reports whether the request that failed with err should be
+	// retried. IsRetriable is used if nil.
+	Retriable func(err error) bool
+}
+
+// DefaultRetryPolicy returns the recommended retry policy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+		Retriable:   IsRetriable,
+	}
+}
+
+// IsRetriable reports whether err is a temporary failure: a transport error
+// of an idempotent request, an exceeded rate limit, an internal error or a
+// gateway timeout.
+func IsRetriable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var (
+		transportError      *TransportError
+		apiRateLimitError   *APIRateLimitError
+		internalError       *InternalError
+		gatewayTimeoutError *GatewayTimeoutError
+	)
+
+	return (errors.As(err, &transportError) && transportError.Idempotent) ||
+		errors.As(err, &apiRateLimitError) ||
+		errors.As(err, &internalError) ||
+		errors.As(err, &gatewayTimeoutError)
+}
+
+func (p RetryPolicy) retriable(err error) bool {
+	if p.Retriable == nil {
+		return IsRetriable(err)
+	}
+
+	return p.Retriable(err)
+}
+
+// delay returns the pause before the next attempt, the Retry-After header of
+// APIRateLimitError takes precedence over the exponential backoff, both are
+// capped by MaxDelay.
+func (p RetryPolicy) delay(attempt int, err error) time.Duration {
+	var apiRateLimitError *APIRateLimitError
+	if errors.As(err, &apiRateLimitError) && apiRateLimitError.RetryAfter > 0 {
+		if p.MaxDelay > 0 && apiRateLimitError.RetryAfter > p.MaxDelay {
+			return p.MaxDelay
+		}
+
+		return apiRateLimitError.RetryAfter
+	}
+
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(delay)
+}
@@ -0,0 +1,151 @@
+package intento_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_retryPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	var bodies []string
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			bodies = append(bodies, string(body))
+
+			switch len(bodies) {
+			case 1:
+				return nil, errors.New("connection reset")
+			case 2:
+				return &http.Response{
+					StatusCode: 429,
+					Header:     http.Header{"Retry-After": []string{"0"}},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			default:
+				return &http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(strings.NewReader(`{"results":["Hola"]}`)),
+				}, nil
+			}
+		},
+	}
+
+	policy := intento.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+
+	client := intento.New(
+		"api_key_1",
+		intento.ClientWithHttpClient(mockHttpClient),
+		intento.ClientWithRetryPolicy(policy),
+	)
+
+	result, err := client.Translate(ctx, text, "en", "es")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Hola"}, result.Results)
+	assert.Len(t, bodies, 3)
+	assert.Equal(t, bodies[0], bodies[2])
+}
+
+func TestClient_retryPolicy_notRetriable(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 403,
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		},
+	}
+
+	client := intento.New(
+		"api_key_1",
+		intento.ClientWithHttpClient(mockHttpClient),
+		intento.ClientWithRetryPolicy(intento.DefaultRetryPolicy()),
+	)
+
+	_, err := client.Translate(ctx, text, "en", "es")
+
+	var authKeyIsInvalidError *intento.AuthKeyIsInvalidError
+	assert.ErrorAs(t, err, &authKeyIsInvalidError)
+	assert.Len(t, mockHttpClient.DoCalls(), 1)
+}
+
+func TestClient_retryPolicy_notIdempotent(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection reset")
+		},
+	}
+
+	policy := intento.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+
+	client := intento.New(
+		"api_key_1",
+		intento.ClientWithHttpClient(mockHttpClient),
+		intento.ClientWithRetryPolicy(policy),
+	)
+
+	_, err := client.TranslateAsync(ctx, text, "en", "es")
+
+	var transportError *intento.TransportError
+	if assert.ErrorAs(t, err, &transportError) {
+		assert.False(t, transportError.Idempotent)
+	}
+	assert.False(t, intento.IsRetriable(err))
+	assert.Len(t, mockHttpClient.DoCalls(), 1)
+
+	_, err = client.Translate(ctx, text, "en", "es")
+	assert.True(t, intento.IsRetriable(err))
+	assert.Len(t, mockHttpClient.DoCalls(), 5)
+}
+
+func TestClient_retryPolicy_maxDelay(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 429,
+				Header:     http.Header{"Retry-After": []string{"3600"}},
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		},
+	}
+
+	policy := intento.DefaultRetryPolicy()
+	policy.MaxAttempts = 2
+	policy.MaxDelay = 10 * time.Millisecond
+
+	client := intento.New(
+		"api_key_1",
+		intento.ClientWithHttpClient(mockHttpClient),
+		intento.ClientWithRetryPolicy(policy),
+	)
+
+	start := time.Now()
+
+	_, err := client.Translate(ctx, text, "en", "es")
+
+	var apiRateLimitError *intento.APIRateLimitError
+	assert.ErrorAs(t, err, &apiRateLimitError)
+	assert.Less(t, time.Since(start), time.Second, "Retry-After is capped by MaxDelay")
+	assert.Len(t, mockHttpClient.DoCalls(), 2)
+}
@@ -0,0 +1,149 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+)
+
+// Sentiment describes the sentiment of a text item.
+type Sentiment struct {
+	// Polarity is positive, negative or neutral.
+	Polarity string `json:"polarity"`
+	// Score is the polarity score from -1 (negative) to 1 (positive).
+	Score float64 `json:"score"`
+	// Subjectivity is objective or subjective.
+	Subjectivity string `json:"subjectivity"`
+}
+
+// SentimentResult describes a result of sentiment analysis.
+type SentimentResult struct {
+	ID      string      `json:"id"`
+	Results []Sentiment `json:"results"`
+	Service ServiceInfo `json:"service"`
+}
+
+// AnalyzeSentiment analyzes the sentiment of every text item.
+func (c *Client) AnalyzeSentiment(
+	ctx context.Context,
+	text []string,
+	options ...SentimentOption,
+) (SentimentResult, error) {
+	params := newSentimentOptions(text, options)
+
+	var result SentimentResult
+
+	err := c.intentRequest(ctx, c.url(endpointSentiment), text, false, &params, &result)
+	if err != nil {
+		return SentimentResult{}, err
+	}
+
+	return result, nil
+}
+
+// AnalyzeSentimentAsync submits text for sentiment analysis in the
+// asynchronous mode, use Wait and Operation.SentimentResult to get the result.
+func (c *Client) AnalyzeSentimentAsync(
+	ctx context.Context,
+	text []string,
+	options ...SentimentOption,
+) (Operation, error) {
+	params := newSentimentOptions(text, options)
+	params.Service.Async = true
+
+	var operation Operation
+
+	err := c.intentRequest(ctx, c.url(endpointSentimentAsync), text, true, &params, &operation)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	return operation, nil
+}
+
+// SentimentResult returns the result of a finished asynchronous sentiment analysis.
+func (o Operation) SentimentResult() (SentimentResult, error) {
+	var results []SentimentResult
+
+	err := o.DecodeResponse(&results)
+	if err != nil {
+		return SentimentResult{}, err
+	}
+
+	if len(results) == 0 {
+		return SentimentResult{}, fmt.Errorf("intento: operation %s has empty response", o.ID)
+	}
+
+	result := results[0]
+	result.ID = o.ID
+
+	return result, nil
+}
+
+func newSentimentOptions(text []string, options []SentimentOption) sentimentOptions {
+	params := sentimentOptions{}
+	params.Context.Text = text
+
+	for _, opt := range options {
+		opt.applySentiment(&params)
+	}
+
+	return params
+}
+
+// SentimentWithLanguage sets the language of the text, otherwise the
+// provider detects it.
+func SentimentWithLanguage(language string) SentimentOption {
+	return newFuncSentimentOption(func(o *sentimentOptions) {
+		o.Context.Lang = language
+	})
+}
+
+// SentimentWithProvider sets a sentiment analysis provider.
+func SentimentWithProvider(providerID string) SentimentOption {
+	return newFuncSentimentOption(func(o *sentimentOptions) {
+		o.Service.Provider = providerID
+	})
+}
+
+// SentimentWithSmartRouting sets a smart routing, see TranslationWithSmartRouting.
+func SentimentWithSmartRouting(routing string) SentimentOption {
+	return newFuncSentimentOption(func(o *sentimentOptions) {
+		o.Service.Routing = routing
+	})
+}
+
+// SentimentWithTrace sets trace flag, see TranslationWithTrace.
+func SentimentWithTrace() SentimentOption {
+	return newFuncSentimentOption(func(o *sentimentOptions) {
+		o.Service.Trace = true
+	})
+}
+
+// SentimentOption configures how we analyze a sentiment.
+type SentimentOption interface {
+	applySentiment(*sentimentOptions)
+}
+
+// sentimentOptions configure a sentiment analysis process.
+type sentimentOptions struct {
+	Context struct {
+		Text []string `json:"text,omitempty"`
+		Lang string   `json:"lang,omitempty"`
+	} `json:"context"`
+	Service serviceOptions `json:"service"`
+}
+
+// funcSentimentOption wraps a function that modifies sentimentOptions into an implementation of the SentimentOption interface.
+type funcSentimentOption struct {
+	fn func(*sentimentOptions)
+}
+
+func (fso *funcSentimentOption) applySentiment(do *sentimentOptions) {
+	fso.fn(do)
+}
+
+func newFuncSentimentOption(fn func(*sentimentOptions)) *funcSentimentOption {
+	return &funcSentimentOption{
+		fn: fn,
+	}
+}
@@ -0,0 +1,47 @@
+package intento_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestClient_AnalyzeSentimentAsync(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.Respond(http.MethodPost, "/ai/text/sentiment", intentotest.Response{Body: `{"id":"op-1"}`})
+	server.Respond(http.MethodGet, "/operations/op-1", intentotest.Response{
+		Body: `{"id":"op-1","done":true,"response":[{"results":[{"polarity":"positive","score":0.8,"subjectivity":"subjective"}]}]}`,
+	})
+
+	client := server.Client()
+
+	operation, err := client.AnalyzeSentimentAsync(
+		ctx,
+		[]string{"I love it"},
+		intento.SentimentWithLanguage("en"),
+		intento.SentimentWithProvider("p1"),
+	)
+	assert.NoError(t, err)
+
+	operation, err = client.Wait(ctx, operation.ID)
+	assert.NoError(t, err)
+
+	result, err := operation.SentimentResult()
+	assert.NoError(t, err)
+	assert.Equal(t, []intento.Sentiment{{Polarity: "positive", Score: 0.8, Subjectivity: "subjective"}}, result.Results)
+
+	assert.JSONEq(
+		t,
+		`{"context":{"text":["I love it"],"lang":"en"},"service":{"provider":"p1","async":true}}`,
+		string(server.Requests()[0].Body),
+	)
+}
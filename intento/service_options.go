@@ -0,0 +1,145 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServiceWithProvider sets the provider of any intent.
+func ServiceWithProvider(providerID string) ServiceOption {
+	return newFuncServiceOption(func(o *serviceOptions) {
+		o.Provider = providerID
+	})
+}
+
+// ServiceWithSmartRouting sets a smart routing of any intent, see
+// TranslationWithSmartRouting.
+func ServiceWithSmartRouting(routing string) ServiceOption {
+	return newFuncServiceOption(func(o *serviceOptions) {
+		o.Routing = routing
+	})
+}
+
+// ServiceWithTrace sets trace flag of any intent, see TranslationWithTrace.
+func ServiceWithTrace() ServiceOption {
+	return newFuncServiceOption(func(o *serviceOptions) {
+		o.Trace = true
+	})
+}
+
+// ServiceWithAsync requests the asynchronous mode of an intent sent with
+// Client.Do.
+//
+// It is not accepted by the dedicated methods, which have asynchronous
+// counterparts like Client.TranslateAsync instead.
+func ServiceWithAsync() IntentOption {
+	return newFuncIntentOption(func(o *serviceOptions) {
+		o.Async = true
+	})
+}
+
+// ServiceWithCredentials authenticates the provider with own credentials, see
+// TranslationWithCredentials.
+func ServiceWithCredentials(providerID string, auth ...ProviderAuth) ServiceOption {
+	return newFuncServiceOption(func(o *serviceOptions) {
+		if o.Auth == nil {
+			o.Auth = make(map[string][]ProviderAuth)
+		}
+
+		o.Auth[providerID] = append(o.Auth[providerID], auth...)
+	})
+}
+
+// IntentOption configures the service settings of an intent sent with
+// Client.Do.
+type IntentOption interface {
+	applyService(*serviceOptions)
+}
+
+// ServiceOption configures the service settings shared by all intents.
+//
+// A ServiceOption is accepted wherever an option of a particular intent is,
+// e.g. as a TranslationOption or a DetectionOption.
+type ServiceOption interface {
+	IntentOption
+	TranslationOption
+	DetectionOption
+	SentimentOption
+	TransliterationOption
+	DictionaryOption
+}
+
+// serviceOptions are the service settings shared by all intents.
+type serviceOptions struct {
+	Async    bool                      `json:"async,omitempty"`
+	Trace    bool                      `json:"trace,omitempty"`
+	Provider string                    `json:"provider,omitempty"`
+	Routing  string                    `json:"routing,omitempty"`
+	Auth     map[string][]ProviderAuth `json:"auth,omitempty"`
+}
+
+// funcIntentOption wraps a function that modifies serviceOptions into an implementation of the IntentOption interface.
+type funcIntentOption struct {
+	fn func(*serviceOptions)
+}
+
+func (fio *funcIntentOption) applyService(do *serviceOptions) {
+	fio.fn(do)
+}
+
+func newFuncIntentOption(fn func(*serviceOptions)) *funcIntentOption {
+	return &funcIntentOption{
+		fn: fn,
+	}
+}
+
+// funcServiceOption wraps a function that modifies serviceOptions into an implementation of the ServiceOption interface.
+type funcServiceOption struct {
+	fn func(*serviceOptions)
+}
+
+func (fso *funcServiceOption) applyService(do *serviceOptions) {
+	fso.fn(do)
+}
+
+func (fso *funcServiceOption) applyTranslation(do *translationOptions) {
+	fso.fn(&do.Service.serviceOptions)
+}
+
+func (fso *funcServiceOption) applyDetection(do *detectionOptions) {
+	fso.fn(&do.Service)
+}
+
+func (fso *funcServiceOption) applySentiment(do *sentimentOptions) {
+	fso.fn(&do.Service)
+}
+
+func (fso *funcServiceOption) applyTransliteration(do *transliterationOptions) {
+	fso.fn(&do.Service)
+}
+
+func (fso *funcServiceOption) applyDictionary(do *dictionaryOptions) {
+	fso.fn(&do.Service)
+}
+
+func newFuncServiceOption(fn func(*serviceOptions)) *funcServiceOption {
+	return &funcServiceOption{
+		fn: fn,
+	}
+}
+
+// intentRequest waits for the rate limiter to let the text through and sends
+// the intent request. An asynchronous request creates an operation, so it is
+// not repeated after a transport error.
+func (c *Client) intentRequest(ctx context.Context, url string, text []string, async bool, params, result interface{}) error {
+	err := c.rateLimiter.waitCharacters(ctx, text)
+	if err != nil {
+		return fmt.Errorf("wait for rate limit: %w", err)
+	}
+
+	if async {
+		return c.apiCreateRequest(ctx, url, params, result)
+	}
+
+	return c.apiPostRequest(ctx, url, params, result)
+}
@@ -124,24 +124,73 @@ func TranslationWithProfanityDetection(content []string) TranslationOption {
 	})
 }
 
+// TranslationWithGlossary applies the glossaries with given IDs to the translation.
+//
+// Glossaries are managed with Client.CreateGlossary, Client.UpdateGlossary and
+// related methods.
+func TranslationWithGlossary(glossaryIDs ...string) TranslationOption {
+	return newFuncTranslationOption(func(o *translationOptions) {
+		o.Service.Glossary = glossaryIDs
+	})
+}
+
+// TranslationWithCredentials authenticates the provider with own credentials,
+// so the translation is billed to your own provider contract.
+//
+// Use StoredCredential to refer to delegated credentials registered with
+// Client.AddDelegatedCredential, or pass the provider-specific auth fields inline,
+// e.g. ProviderAuth{"key": "..."}.
+func TranslationWithCredentials(providerID string, auth ...ProviderAuth) TranslationOption {
+	return newFuncTranslationOption(func(o *translationOptions) {
+		if o.Service.Auth == nil {
+			o.Service.Auth = make(map[string][]ProviderAuth)
+		}
+
+		o.Service.Auth[providerID] = append(o.Service.Auth[providerID], auth...)
+	})
+}
+
+// TranslationWithCustomModel translates with a custom model of the provider.
+//
+// The category is the ID of the custom model as returned by
+// Client.CustomModels. The provider must be set with TranslationWithProvider
+// and must support custom models, which is checked before the request is sent.
+func TranslationWithCustomModel(category string) TranslationOption {
+	return newFuncTranslationOption(func(o *translationOptions) {
+		o.Context.Category = category
+	})
+}
+
+// TranslationWithLanguageTags lets the source and target languages be given
+// as BCP-47 tags like "zh-Hant-TW" or locale names like "pt_BR", they are
+// resolved to the codes of Intento with a LanguageResolver.
+//
+// The available languages are requested once per Client, or taken from the
+// cache of ClientWithCatalogCache. A tag that matches no language fails with
+// a *LanguageNotSupportedError before the request is sent.
+func TranslationWithLanguageTags() TranslationOption {
+	return newFuncTranslationOption(func(o *translationOptions) {
+		o.languageTags = true
+	})
+}
+
 // TranslationOption configures how we set up the connection.
 type TranslationOption interface {
-	apply(*translationOptions)
+	applyTranslation(*translationOptions)
 }
 
 // translationOptions configure a translation process.
 type translationOptions struct {
 	Context struct {
-		From   string     `json:"from,omitempty"`
-		To     string     `json:"to,omitempty"`
-		Text   []string   `json:"text,omitempty"`
-		Format TextFormat `json:"format,omitempty"`
+		From     string     `json:"from,omitempty"`
+		To       string     `json:"to,omitempty"`
+		Text     []string   `json:"text,omitempty"`
+		Format   TextFormat `json:"format,omitempty"`
+		Category string     `json:"category,omitempty"`
 	} `json:"context"`
 	Service struct {
-		Async    bool   `json:"async,omitempty"`
-		Trace    bool   `json:"trace,omitempty"`
-		Provider string `json:"provider,omitempty"`
-		Routing  string `json:"routing,omitempty"`
+		serviceOptions
+		Glossary []string `json:"glossary,omitempty"`
 		Cache    struct {
 			Apply  bool `json:"apply,omitempty"`
 			Update bool `json:"update,omitempty"`
@@ -157,6 +206,9 @@ type translationOptions struct {
 			Content []string `json:"content,omitempty"`
 		} `json:"moderation,omitempty"`
 	} `json:"service"`
+
+	// languageTags makes From and To resolved as language tags.
+	languageTags bool
 }
 
 // funcTranslationOption wraps a function that modifies clientOptions into an implementation of the ClientOption interface.
@@ -164,7 +216,7 @@ type funcTranslationOption struct {
 	fn func(*translationOptions)
 }
 
-func (fco *funcTranslationOption) apply(do *translationOptions) {
+func (fco *funcTranslationOption) applyTranslation(do *translationOptions) {
 	fco.fn(do)
 }
 
@@ -0,0 +1,201 @@
+package intento
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Translator translates text, it is implemented by Client and by the
+// decorators of this package.
+type Translator interface {
+	Translate(ctx context.Context, text []string, from string, to string, options ...TranslationOption) (TranslationResult, error)
+}
+
+// Catalog lists the providers, languages and smart routing schemes of the API.
+type Catalog interface {
+	AvailableProviders(ctx context.Context) ([]Provider, error)
+	Provider(ctx context.Context, id string) (Provider, error)
+	AvailableLanguages(ctx context.Context) ([]Language, error)
+	SmartRoutingList(ctx context.Context) ([]SmartRouting, error)
+}
+
+// LanguageDetector detects the language of text.
+type LanguageDetector interface {
+	DetectLanguage(ctx context.Context, text []string, options ...DetectionOption) (DetectionResult, error)
+}
+
+var (
+	_ Translator       = (*Client)(nil)
+	_ Catalog          = (*Client)(nil)
+	_ LanguageDetector = (*Client)(nil)
+)
+
+// TranslatorFunc is an adapter to use an ordinary function as a Translator.
+type TranslatorFunc func(ctx context.Context, text []string, from string, to string, options ...TranslationOption) (TranslationResult, error)
+
+// Translate calls f.
+func (f TranslatorFunc) Translate(
+	ctx context.Context,
+	text []string,
+	from string,
+	to string,
+	options ...TranslationOption,
+) (TranslationResult, error) {
+	return f(ctx, text, from, to, options...)
+}
+
+// NewCachingTranslator returns a Translator that translates only the text
+// items missing from the cache, see ClientWithCache. Failing cache calls are
+// logged and treated as misses, nil logger means the standard logger.
+func NewCachingTranslator(next Translator, cache Cache, logger Logger) Translator {
+	if logger == nil {
+		logger = defaultLogger
+	}
+
+	return TranslatorFunc(func(
+		ctx context.Context,
+		text []string,
+		from string,
+		to string,
+		options ...TranslationOption,
+	) (TranslationResult, error) {
+		params := newTranslationOptions(text, from, to, options)
+
+		return translateWithCache(ctx, cache, logger, params, func(ctx context.Context, params translationOptions) (TranslationResult, error) {
+			return next.Translate(ctx, params.Context.Text, from, to, options...)
+		})
+	})
+}
+
+// NewLoggingTranslator returns a Translator that logs every translation with
+// its duration and error, nil logger means the standard logger.
+func NewLoggingTranslator(next Translator, logger Logger) Translator {
+	if logger == nil {
+		logger = defaultLogger
+	}
+
+	return TranslatorFunc(func(
+		ctx context.Context,
+		text []string,
+		from string,
+		to string,
+		options ...TranslationOption,
+	) (TranslationResult, error) {
+		start := time.Now()
+
+		result, err := next.Translate(ctx, text, from, to, options...)
+		if err != nil {
+			logger(ctx, "translate %d items from %q to %q failed in %s: %v", len(text), from, to, time.Since(start), err)
+		} else {
+			logger(ctx, "translate %d items from %q to %q done in %s", len(text), from, to, time.Since(start))
+		}
+
+		return result, err
+	})
+}
+
+// TranslationObservation describes a translation done by the Translator
+// returned by NewMetricsTranslator.
+type TranslationObservation struct {
+	From       string
+	To         string
+	Items      int
+	Characters int
+	Duration   time.Duration
+	Err        error
+}
+
+// TranslationObserver receives the observations of translations, it is meant
+// to feed a metrics library.
+type TranslationObserver func(ctx context.Context, observation TranslationObservation)
+
+// NewMetricsTranslator returns a Translator that passes every translation to
+// the observer.
+func NewMetricsTranslator(next Translator, observer TranslationObserver) Translator {
+	return TranslatorFunc(func(
+		ctx context.Context,
+		text []string,
+		from string,
+		to string,
+		options ...TranslationOption,
+	) (TranslationResult, error) {
+		start := time.Now()
+
+		result, err := next.Translate(ctx, text, from, to, options...)
+
+		observer(ctx, TranslationObservation{
+			From:       from,
+			To:         to,
+			Items:      len(text),
+			Characters: countCharacters(text),
+			Duration:   time.Since(start),
+			Err:        err,
+		})
+
+		return result, err
+	})
+}
+
+// FallbackError is returned by the Translator of NewFallbackTranslator when
+// all translators have failed.
+type FallbackError struct {
+	Errs []error
+}
+
+func (e *FallbackError) Error() string {
+	messages := make([]string, 0, len(e.Errs))
+	for i, err := range e.Errs {
+		messages = append(messages, fmt.Sprintf("translator %d: %v", i, err))
+	}
+
+	return "intento: all translators failed: " + strings.Join(messages, "; ")
+}
+
+func (e *FallbackError) Unwrap() []error {
+	return e.Errs
+}
+
+// As finds the first error of the translators that matches target.
+func (e *FallbackError) As(target interface{}) bool {
+	return asAny(e.Errs, target)
+}
+
+// Is reports whether any error of the translators matches target.
+func (e *FallbackError) Is(target error) bool {
+	return isAny(e.Errs, target)
+}
+
+// NewFallbackTranslator returns a Translator that tries the translators in
+// order until one of them succeeds.
+//
+// A canceled or expired context stops the fallback. If all translators fail,
+// the error is a *FallbackError.
+func NewFallbackTranslator(translators ...Translator) Translator {
+	return TranslatorFunc(func(
+		ctx context.Context,
+		text []string,
+		from string,
+		to string,
+		options ...TranslationOption,
+	) (TranslationResult, error) {
+		var fallbackError FallbackError
+
+		for _, translator := range translators {
+			result, err := translator.Translate(ctx, text, from, to, options...)
+			if err == nil {
+				return result, nil
+			}
+
+			fallbackError.Errs = append(fallbackError.Errs, err)
+
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil {
+				break
+			}
+		}
+
+		return TranslationResult{}, &fallbackError
+	})
+}
@@ -0,0 +1,147 @@
+package intento_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestNewCachingTranslator(t *testing.T) {
+	ctx := context.Background()
+
+	var requested [][]string
+
+	next := intento.TranslatorFunc(func(
+		ctx context.Context,
+		text []string,
+		from string,
+		to string,
+		options ...intento.TranslationOption,
+	) (intento.TranslationResult, error) {
+		requested = append(requested, text)
+		return intentotest.PseudoTranslator{}.Translate(ctx, text, from, to, options...)
+	})
+
+	translator := intento.NewCachingTranslator(next, intento.NewMemoryCache(10, 0), nil)
+
+	_, err := translator.Translate(ctx, []string{"one"}, "en", "de")
+	assert.NoError(t, err)
+
+	result, err := translator.Translate(ctx, []string{"one", "two"}, "en", "de")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[óñé~]", "[ţŵó~]"}, result.Results)
+	assert.Equal(t, [][]string{{"one"}, {"two"}}, requested)
+}
+
+func TestNewLoggingTranslator(t *testing.T) {
+	ctx := context.Background()
+
+	var messages []string
+
+	logger := func(ctx context.Context, format string, args ...interface{}) {
+		messages = append(messages, fmt.Sprintf(format, args...))
+	}
+
+	failing := intento.TranslatorFunc(func(
+		ctx context.Context,
+		text []string,
+		from string,
+		to string,
+		options ...intento.TranslationOption,
+	) (intento.TranslationResult, error) {
+		return intento.TranslationResult{}, errors.New("boom")
+	})
+
+	_, err := intento.NewLoggingTranslator(intentotest.PseudoTranslator{}, logger).Translate(ctx, []string{"a"}, "en", "de")
+	assert.NoError(t, err)
+
+	_, err = intento.NewLoggingTranslator(failing, logger).Translate(ctx, []string{"a"}, "en", "de")
+	assert.EqualError(t, err, "boom")
+
+	if assert.Len(t, messages, 2) {
+		assert.Contains(t, messages[0], `translate 1 items from "en" to "de" done in`)
+		assert.Contains(t, messages[1], `translate 1 items from "en" to "de" failed in`)
+		assert.Contains(t, messages[1], "boom")
+	}
+
+	var output bytes.Buffer
+
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	_, err = intento.NewLoggingTranslator(intentotest.PseudoTranslator{}, nil).Translate(ctx, []string{"a"}, "en", "de")
+	assert.NoError(t, err)
+	assert.Contains(t, output.String(), `translate 1 items from "en" to "de" done in`)
+}
+
+func TestNewMetricsTranslator(t *testing.T) {
+	ctx := context.Background()
+
+	var observations []intento.TranslationObservation
+
+	translator := intento.NewMetricsTranslator(
+		intentotest.PseudoTranslator{},
+		func(ctx context.Context, observation intento.TranslationObservation) {
+			observations = append(observations, observation)
+		},
+	)
+
+	_, err := translator.Translate(ctx, []string{"héllo", "world"}, "en", "de")
+	assert.NoError(t, err)
+
+	if assert.Len(t, observations, 1) {
+		assert.Equal(t, "en", observations[0].From)
+		assert.Equal(t, "de", observations[0].To)
+		assert.Equal(t, 2, observations[0].Items)
+		assert.Equal(t, 10, observations[0].Characters)
+		assert.NoError(t, observations[0].Err)
+	}
+}
+
+func TestNewFallbackTranslator(t *testing.T) {
+	ctx := context.Background()
+
+	errFirst := errors.New("first failed")
+
+	first := intento.TranslatorFunc(func(
+		ctx context.Context,
+		text []string,
+		from string,
+		to string,
+		options ...intento.TranslationOption,
+	) (intento.TranslationResult, error) {
+		return intento.TranslationResult{}, errFirst
+	})
+
+	result, err := intento.NewFallbackTranslator(first, intentotest.PseudoTranslator{}).Translate(ctx, []string{"a"}, "en", "de")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[á~]"}, result.Results)
+
+	_, err = intento.NewFallbackTranslator(first, first).Translate(ctx, []string{"a"}, "en", "de")
+
+	var fallbackError *intento.FallbackError
+	if assert.ErrorAs(t, err, &fallbackError) {
+		assert.Len(t, fallbackError.Errs, 2)
+	}
+	assert.ErrorIs(t, err, errFirst)
+	assert.True(t, fallbackError.Is(errFirst))
+	assert.False(t, fallbackError.Is(context.Canceled))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err = intento.NewFallbackTranslator(intentotest.PseudoTranslator{}, first).Translate(canceled, []string{"a"}, "en", "de")
+	assert.ErrorIs(t, err, context.Canceled)
+	if assert.ErrorAs(t, err, &fallbackError) {
+		assert.Len(t, fallbackError.Errs, 1)
+	}
+}
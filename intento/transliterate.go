@@ -0,0 +1,114 @@
+package intento
+
+import "context"
+
+// TransliterationResult describes a result of transliteration.
+type TransliterationResult struct {
+	ID      string      `json:"id"`
+	Results []string    `json:"results"`
+	Service ServiceInfo `json:"service"`
+}
+
+// ScriptPair describes a supported transliteration direction.
+type ScriptPair struct {
+	// Language is the language of the text, e.g. "ru".
+	Language string `json:"lang"`
+	// From and To are ISO 15924 script codes, e.g. "Cyrl" and "Latn".
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Transliterate converts text in given language from one script to another.
+//
+// Scripts are ISO 15924 codes, e.g. Transliterate(ctx, text, "Cyrl", "Latn", "ru").
+func (c *Client) Transliterate(
+	ctx context.Context,
+	text []string,
+	fromScript string,
+	toScript string,
+	language string,
+	options ...TransliterationOption,
+) (TransliterationResult, error) {
+	params := transliterationOptions{}
+	params.Context.Text = text
+	params.Context.From = fromScript
+	params.Context.To = toScript
+	params.Context.Lang = language
+
+	for _, opt := range options {
+		opt.applyTransliteration(&params)
+	}
+
+	var result TransliterationResult
+
+	err := c.intentRequest(ctx, c.url(endpointTransliterate), text, false, &params, &result)
+	if err != nil {
+		return TransliterationResult{}, err
+	}
+
+	return result, nil
+}
+
+// TransliterationScripts returns the list of supported transliteration directions.
+func (c *Client) TransliterationScripts(ctx context.Context) ([]ScriptPair, error) {
+	var pairs []ScriptPair
+
+	err := c.apiGetRequest(ctx, c.url(endpointTransliterationScripts), &pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// TransliterationWithProvider sets a transliteration provider.
+func TransliterationWithProvider(providerID string) TransliterationOption {
+	return newFuncTransliterationOption(func(o *transliterationOptions) {
+		o.Service.Provider = providerID
+	})
+}
+
+// TransliterationWithSmartRouting sets a smart routing, see TranslationWithSmartRouting.
+func TransliterationWithSmartRouting(routing string) TransliterationOption {
+	return newFuncTransliterationOption(func(o *transliterationOptions) {
+		o.Service.Routing = routing
+	})
+}
+
+// TransliterationWithTrace sets trace flag, see TranslationWithTrace.
+func TransliterationWithTrace() TransliterationOption {
+	return newFuncTransliterationOption(func(o *transliterationOptions) {
+		o.Service.Trace = true
+	})
+}
+
+// TransliterationOption configures how we transliterate.
+type TransliterationOption interface {
+	applyTransliteration(*transliterationOptions)
+}
+
+// transliterationOptions configure a transliteration process.
+type transliterationOptions struct {
+	Context struct {
+		Text []string `json:"text,omitempty"`
+		From string   `json:"from,omitempty"`
+		To   string   `json:"to,omitempty"`
+		Lang string   `json:"lang,omitempty"`
+	} `json:"context"`
+	Service serviceOptions `json:"service"`
+}
+
+// funcTransliterationOption wraps a function that modifies transliterationOptions into an implementation of the TransliterationOption interface.
+type funcTransliterationOption struct {
+	fn func(*transliterationOptions)
+}
+
+func (fto *funcTransliterationOption) applyTransliteration(do *transliterationOptions) {
+	fto.fn(do)
+}
+
+func newFuncTransliterationOption(fn func(*transliterationOptions)) *funcTransliterationOption {
+	return &funcTransliterationOption{
+		fn: fn,
+	}
+}
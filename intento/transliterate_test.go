@@ -0,0 +1,39 @@
+package intento_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func TestClient_Transliterate(t *testing.T) {
+	ctx := context.Background()
+
+	server := intentotest.NewServer()
+	defer server.Close()
+
+	server.Respond(http.MethodPost, "/ai/text/transliterate", intentotest.Response{Body: `{"results":["Ivan"]}`})
+	server.Respond(http.MethodPost, "/ai/text/transliterate", intentotest.ErrorResponse(http.StatusRequestEntityTooLarge, "unsupported script"))
+
+	client := server.Client()
+
+	result, err := client.Transliterate(ctx, []string{"Иван"}, "Cyrl", "Latn", "ru", intento.TransliterationWithProvider("p1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Ivan"}, result.Results)
+
+	assert.JSONEq(
+		t,
+		`{"context":{"text":["Иван"],"from":"Cyrl","to":"Latn","lang":"ru"},"service":{"provider":"p1"}}`,
+		string(server.Requests()[0].Body),
+	)
+
+	_, err = client.Transliterate(ctx, []string{"Иван"}, "Cyrl", "Hira", "ru")
+
+	var capabilitiesMismatchError *intento.CapabilitiesMismatchError
+	assert.ErrorAs(t, err, &capabilitiesMismatchError)
+}
@@ -0,0 +1,161 @@
+package intento
+
+import (
+	"context"
+	"time"
+)
+
+// UsageScope selects how the usage statistics are grouped.
+type UsageScope string
+
+const (
+	// UsageScopeIntent groups the usage by intents.
+	UsageScopeIntent UsageScope = "intento"
+	// UsageScopeProvider groups the usage by providers.
+	UsageScopeProvider UsageScope = "provider"
+)
+
+// UsageInterval is the size of the time bucket the usage is aggregated in.
+type UsageInterval string
+
+const (
+	UsageIntervalHour  UsageInterval = "1hour"
+	UsageIntervalDay   UsageInterval = "1day"
+	UsageIntervalWeek  UsageInterval = "1week"
+	UsageIntervalMonth UsageInterval = "1month"
+)
+
+// UsageReport describes the consumption of the Intento API.
+type UsageReport struct {
+	Data []UsageRecord `json:"data"`
+}
+
+// UsageRecord describes the consumption within a time bucket.
+type UsageRecord struct {
+	// Timestamp is the start of the time bucket in Unix seconds.
+	Timestamp int64        `json:"timestamp"`
+	Group     UsageGroup   `json:"group"`
+	Metrics   UsageMetrics `json:"metrics"`
+}
+
+// Time returns the start of the time bucket.
+func (r UsageRecord) Time() time.Time {
+	return time.Unix(r.Timestamp, 0)
+}
+
+// UsageGroup describes what the usage record is aggregated by.
+type UsageGroup struct {
+	Provider string `json:"provider,omitempty"`
+	Intent   string `json:"intent,omitempty"`
+}
+
+// UsageMetrics describes the amount of consumed resources.
+type UsageMetrics struct {
+	Requests int `json:"requests"`
+	Items    int `json:"items"`
+	// Len is the number of characters.
+	Len    int `json:"len"`
+	Errors int `json:"errors"`
+}
+
+// Total returns the sum of metrics over all records of the report.
+func (r UsageReport) Total() UsageMetrics {
+	var total UsageMetrics
+
+	for _, record := range r.Data {
+		total.Requests += record.Metrics.Requests
+		total.Items += record.Metrics.Items
+		total.Len += record.Metrics.Len
+		total.Errors += record.Metrics.Errors
+	}
+
+	return total
+}
+
+// Usage returns the usage statistics grouped by scope.
+//
+// Without options, the statistics for the last day aggregated by hour are
+// returned, see UsageWithRange and UsageWithInterval.
+func (c *Client) Usage(ctx context.Context, scope UsageScope, options ...UsageOption) (UsageReport, error) {
+	to := time.Now()
+
+	params := usageOptions{}
+	params.Range.From = to.Add(-24 * time.Hour).Unix()
+	params.Range.To = to.Unix()
+	params.Range.Bucket = UsageIntervalHour
+
+	for _, opt := range options {
+		opt.apply(&params)
+	}
+
+	var report UsageReport
+
+	err := c.apiPostRequest(ctx, c.url(endpointUsage, string(scope)), &params, &report)
+	if err != nil {
+		return UsageReport{}, err
+	}
+
+	return report, nil
+}
+
+// UsageWithRange sets the time range of the statistics.
+func UsageWithRange(from, to time.Time) UsageOption {
+	return newFuncUsageOption(func(o *usageOptions) {
+		o.Range.From = from.Unix()
+		o.Range.To = to.Unix()
+	})
+}
+
+// UsageWithInterval sets the size of the time bucket the statistics are aggregated in.
+func UsageWithInterval(interval UsageInterval) UsageOption {
+	return newFuncUsageOption(func(o *usageOptions) {
+		o.Range.Bucket = interval
+	})
+}
+
+// UsageWithProvider limits the statistics to given providers.
+func UsageWithProvider(providerIDs ...string) UsageOption {
+	return newFuncUsageOption(func(o *usageOptions) {
+		o.Filter.Provider = providerIDs
+	})
+}
+
+// UsageWithIntent limits the statistics to given intents, e.g. "ai.text.translate".
+func UsageWithIntent(intents ...string) UsageOption {
+	return newFuncUsageOption(func(o *usageOptions) {
+		o.Filter.Intent = intents
+	})
+}
+
+// UsageOption configures a usage query.
+type UsageOption interface {
+	apply(*usageOptions)
+}
+
+// usageOptions configure a usage query.
+type usageOptions struct {
+	Range struct {
+		From   int64         `json:"from"`
+		To     int64         `json:"to"`
+		Bucket UsageInterval `json:"bucket,omitempty"`
+	} `json:"range"`
+	Filter struct {
+		Provider []string `json:"provider,omitempty"`
+		Intent   []string `json:"intent,omitempty"`
+	} `json:"filter"`
+}
+
+// funcUsageOption wraps a function that modifies usageOptions into an implementation of the UsageOption interface.
+type funcUsageOption struct {
+	fn func(*usageOptions)
+}
+
+func (fuo *funcUsageOption) apply(do *usageOptions) {
+	fuo.fn(do)
+}
+
+func newFuncUsageOption(fn func(*usageOptions)) *funcUsageOption {
+	return &funcUsageOption{
+		fn: fn,
+	}
+}
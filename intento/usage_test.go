@@ -0,0 +1,59 @@
+package intento_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+)
+
+func TestClient_Usage(t *testing.T) {
+	ctx := context.Background()
+
+	mockHttpClient := &HttpClientMock{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body: io.NopCloser(strings.NewReader(`{"data":[
+					{"timestamp":1600000000,"group":{"provider":"p1"},"metrics":{"requests":2,"items":3,"len":40,"errors":0}},
+					{"timestamp":1600086400,"group":{"provider":"p1"},"metrics":{"requests":1,"items":1,"len":10,"errors":1}}
+				]}`)),
+			}, nil
+		},
+	}
+
+	client := intento.New("api_key_1", intento.ClientWithHttpClient(mockHttpClient))
+
+	from := time.Unix(1600000000, 0)
+	to := time.Unix(1600172800, 0)
+
+	report, err := client.Usage(
+		ctx,
+		intento.UsageScopeProvider,
+		intento.UsageWithRange(from, to),
+		intento.UsageWithInterval(intento.UsageIntervalDay),
+		intento.UsageWithProvider("p1"),
+	)
+
+	assert.NoError(t, err)
+	assert.Len(t, report.Data, 2)
+	assert.Equal(t, from, report.Data[0].Time())
+	assert.Equal(t, intento.UsageMetrics{Requests: 3, Items: 4, Len: 50, Errors: 1}, report.Total())
+
+	req := mockHttpClient.DoCalls()[0].Req
+	assert.Equal(t, "/usage/provider", req.URL.Path)
+
+	body, err := io.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"range":{"from":1600000000,"to":1600172800,"bucket":"1day"},"filter":{"provider":["p1"]}}`,
+		string(body),
+	)
+}
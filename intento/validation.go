@@ -0,0 +1,243 @@
+package intento
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ValidationProblem describes a mistake in a request.
+type ValidationProblem struct {
+	// Field is the setting the problem is about, e.g. "from" or "provider".
+	Field   string
+	Message string
+}
+
+// ValidationError is returned when a request fails the pre-flight validation,
+// it lists every problem found.
+type ValidationError struct {
+	Problems []ValidationProblem
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Problems))
+	for _, problem := range e.Problems {
+		messages = append(messages, problem.Field+": "+problem.Message)
+	}
+
+	return "intento: invalid request: " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+	e.Problems = append(e.Problems, ValidationProblem{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// ValidateTranslation checks a translation request with given settings
+// against the catalog of providers and languages without sending it.
+//
+// It checks the language codes, the support of the pair by the provider or
+// by any provider, the use of an async-only provider in the synchronous mode
+// and the combinations of options. The problems found are returned as a
+// *ValidationError. With TranslationWithLanguageTags, the tags are resolved
+// first, the way Translate does.
+func (c *Client) ValidateTranslation(
+	ctx context.Context,
+	text []string,
+	from string,
+	to string,
+	options ...TranslationOption,
+) error {
+	return c.checkTranslation(ctx, newTranslationOptions(text, from, to, options))
+}
+
+// ValidateTranslationAsync checks a request of Client.TranslateAsync the same
+// way as ValidateTranslation.
+func (c *Client) ValidateTranslationAsync(
+	ctx context.Context,
+	text []string,
+	from string,
+	to string,
+	options ...TranslationOption,
+) error {
+	params := newTranslationOptions(text, from, to, options)
+	params.Service.Async = true
+
+	return c.checkTranslation(ctx, params)
+}
+
+// checkTranslation resolves the language tags of the translation and
+// validates it.
+func (c *Client) checkTranslation(ctx context.Context, params translationOptions) error {
+	if params.languageTags {
+		err := c.resolveLanguageTags(ctx, &params)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.validateTranslation(ctx, params)
+}
+
+func (c *Client) validateTranslation(ctx context.Context, params translationOptions) error {
+	catalog, err := c.catalog.providerCatalog(ctx, c)
+	if err != nil {
+		return fmt.Errorf("get catalog: %w", err)
+	}
+
+	languages, err := c.catalog.languageResolver(ctx, c)
+	if err != nil {
+		return fmt.Errorf("get languages: %w", err)
+	}
+
+	var validationError ValidationError
+
+	from, to := params.Context.From, params.Context.To
+
+	if len(params.Context.Text) == 0 {
+		validationError.add("text", "no text to translate")
+	}
+
+	// The codes are compared and reported the way Intento writes them.
+	fromKnown := from == AutoDetectSourceLanguage
+	if !fromKnown {
+		code, ok := languages.code(from)
+		if ok {
+			from, fromKnown = code, true
+		} else {
+			validationError.add("from", "unknown language %q", from)
+		}
+	}
+
+	toKnown := false
+	if to == "" {
+		validationError.add("to", "target language is required")
+	} else if code, ok := languages.code(to); !ok {
+		validationError.add("to", "unknown language %q", to)
+	} else {
+		to, toKnown = code, true
+	}
+
+	if toKnown && from == to {
+		validationError.add("to", "target language is the same as the source language")
+	}
+
+	pairKnown := fromKnown && toKnown && from != to
+	service := params.Service
+
+	if service.Provider != "" {
+		provider, ok := catalog.Provider(service.Provider)
+
+		if !ok {
+			validationError.add("provider", "unknown provider %q", service.Provider)
+		} else {
+			if pairKnown && !provider.SupportsPair(from, to) {
+				validationError.add("provider", "provider %q does not translate from %q to %q", provider.ID, from, to)
+			}
+
+			if provider.AsyncOnly && !service.Async {
+				validationError.add("provider", "provider %q works in the asynchronous mode only", provider.ID)
+			}
+
+			if params.Context.Category != "" && !provider.CustomModel {
+				validationError.add("category", "provider %q does not support custom models", provider.ID)
+			}
+		}
+	} else {
+		if pairKnown && !catalog.SupportsPair(from, to) {
+			validationError.add("to", "no provider translates from %q to %q", from, to)
+		}
+
+		if params.Context.Category != "" {
+			validationError.add("category", "custom model requires a provider")
+		}
+	}
+
+	for _, providerID := range sortedAuthProviders(service.Auth) {
+		if _, ok := catalog.Provider(providerID); !ok {
+			validationError.add("auth", "credentials of unknown provider %q", providerID)
+		}
+	}
+
+	noTranslate := service.NoTranslate
+	if noTranslate.Prefix != "" || noTranslate.Suffix != "" {
+		if noTranslate.Prefix == "" || noTranslate.Suffix == "" {
+			validationError.add("notranslate", "both prefix and suffix are required")
+		}
+
+		if params.Context.Format != FormatHTML {
+			validationError.add("format", "NOTRANSLATE protection requires the %q format", FormatHTML)
+		}
+	}
+
+	if len(validationError.Problems) > 0 {
+		return &validationError
+	}
+
+	return nil
+}
+
+// sortedAuthProviders returns the providers of auth in a stable order.
+func sortedAuthProviders(auth map[string][]ProviderAuth) []string {
+	providers := make([]string, 0, len(auth))
+	for providerID := range auth {
+		providers = append(providers, providerID)
+	}
+
+	sort.Strings(providers)
+
+	return providers
+}
+
+// catalogSnapshot holds the providers and languages requested for the
+// validation and the resolution of language tags.
+type catalogSnapshot struct {
+	mu        sync.Mutex
+	providers *ProviderCatalog
+	languages *LanguageResolver
+}
+
+// providerCatalog returns the providers, requesting them on the first call.
+// Failures are not kept, so the next call requests them again. With
+// ClientWithCatalogCache, the catalog is rebuilt from the cache every time.
+func (s *catalogSnapshot) providerCatalog(ctx context.Context, c *Client) (*ProviderCatalog, error) {
+	if c.catalogCache != nil {
+		return c.ProviderCatalog(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.providers == nil {
+		providers, err := c.ProviderCatalog(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.providers = providers
+	}
+
+	return s.providers, nil
+}
+
+// languageResolver returns the languages the same way as providerCatalog.
+func (s *catalogSnapshot) languageResolver(ctx context.Context, c *Client) (*LanguageResolver, error) {
+	if c.catalogCache != nil {
+		return c.LanguageResolver(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.languages == nil {
+		languages, err := c.LanguageResolver(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.languages = languages
+	}
+
+	return s.languages, nil
+}
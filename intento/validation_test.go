@@ -0,0 +1,150 @@
+package intento_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"intento-golang/intento"
+	"intento-golang/intento/intentotest"
+)
+
+func newValidationServer() *intentotest.Server {
+	server := intentotest.NewServer()
+	server.SetProviders(testProviders())
+	server.SetLanguages([]intento.Language{
+		{IntentoCode: "en"},
+		{IntentoCode: "de"},
+		{IntentoCode: "es"},
+		{IntentoCode: "fr"},
+	})
+
+	return server
+}
+
+func TestClient_ValidateTranslation(t *testing.T) {
+	ctx := context.Background()
+
+	server := newValidationServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	assert.NoError(t, client.ValidateTranslation(ctx, []string{"Hello"}, "en", "de"))
+	assert.NoError(t, client.ValidateTranslation(ctx, []string{"Hello"}, "", "fr", intento.TranslationWithProvider("p1")))
+	assert.NoError(t, client.ValidateTranslationAsync(ctx, []string{"Hello"}, "en", "de", intento.TranslationWithProvider("p3")))
+
+	err := client.ValidateTranslation(
+		ctx,
+		[]string{"Hello"},
+		"xx",
+		"de",
+		intento.TranslationWithProvider("p3"),
+		intento.TranslationWithCustomModel("m1"),
+		intento.TranslationWithNoTranslateProtection("<span>", "</span>", true),
+		intento.TranslationWithCredentials("p9", intento.StoredCredential("c1")),
+	)
+
+	var validationError *intento.ValidationError
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.Equal(t, []intento.ValidationProblem{
+			{Field: "from", Message: `unknown language "xx"`},
+			{Field: "provider", Message: `provider "p3" works in the asynchronous mode only`},
+			{Field: "category", Message: `provider "p3" does not support custom models`},
+			{Field: "auth", Message: `credentials of unknown provider "p9"`},
+			{Field: "format", Message: `NOTRANSLATE protection requires the "html" format`},
+		}, validationError.Problems)
+	}
+
+	err = client.ValidateTranslation(ctx, []string{"Hello"}, "fr", "es")
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.Equal(t, []intento.ValidationProblem{
+			{Field: "to", Message: `no provider translates from "fr" to "es"`},
+		}, validationError.Problems)
+	}
+
+	err = client.ValidateTranslation(ctx, []string{"Hello"}, "de", "es", intento.TranslationWithProvider("p1"))
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.Equal(t, []intento.ValidationProblem{
+			{Field: "provider", Message: `provider "p1" does not translate from "de" to "es"`},
+		}, validationError.Problems)
+	}
+
+	assert.Len(t, server.Requests(), 2, "the catalog is requested once")
+}
+
+func TestClient_ValidateTranslation_case(t *testing.T) {
+	ctx := context.Background()
+
+	server := newValidationServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	assert.NoError(t, client.ValidateTranslation(ctx, []string{"Hello"}, "EN", "De"))
+	assert.NoError(t, client.ValidateTranslation(ctx, []string{"Hello"}, "En", "FR", intento.TranslationWithProvider("p1")))
+
+	err := client.ValidateTranslation(ctx, []string{"Hello"}, "en", "EN")
+
+	var validationError *intento.ValidationError
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.Equal(t, []intento.ValidationProblem{
+			{Field: "to", Message: "target language is the same as the source language"},
+		}, validationError.Problems)
+	}
+
+	err = client.ValidateTranslation(ctx, []string{"Hello"}, "DE", "ES", intento.TranslationWithProvider("p1"))
+	if assert.ErrorAs(t, err, &validationError) {
+		assert.Equal(t, []intento.ValidationProblem{
+			{Field: "provider", Message: `provider "p1" does not translate from "de" to "es"`},
+		}, validationError.Problems)
+	}
+}
+
+func TestClient_ValidateTranslation_languageTags(t *testing.T) {
+	ctx := context.Background()
+
+	server := newValidationServer()
+	defer server.Close()
+
+	client := server.Client(intento.ClientWithValidation())
+
+	assert.NoError(t, client.ValidateTranslation(ctx, []string{"Hello"}, "en_US", "de-DE", intento.TranslationWithLanguageTags()))
+	assert.NoError(t, client.ValidateTranslationAsync(ctx, []string{"Hello"}, "en_US", "de-DE", intento.TranslationWithLanguageTags()))
+
+	_, err := client.Translate(ctx, []string{"Hello"}, "en_US", "de-DE", intento.TranslationWithLanguageTags())
+	assert.NoError(t, err)
+
+	err = client.ValidateTranslation(ctx, []string{"Hello"}, "en_US", "ja-JP", intento.TranslationWithLanguageTags())
+	assert.ErrorAs(t, err, new(*intento.LanguageNotSupportedError))
+
+	err = client.ValidateTranslation(ctx, []string{"Hello"}, "en_US", "de-DE")
+	assert.ErrorAs(t, err, new(*intento.ValidationError))
+}
+
+func TestClientWithValidation(t *testing.T) {
+	ctx := context.Background()
+
+	server := newValidationServer()
+	defer server.Close()
+
+	client := server.Client(intento.ClientWithValidation())
+
+	_, err := client.Translate(ctx, []string{"Hello"}, "en", "ja")
+	assert.ErrorAs(t, err, new(*intento.ValidationError))
+
+	result, err := client.Translate(ctx, []string{"Hello"}, "en", "de")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"[de] Hello"}, result.Results)
+
+	var translations int
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost {
+			translations++
+		}
+	}
+
+	assert.Equal(t, 1, translations)
+}